@@ -6,7 +6,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/db"
@@ -26,9 +29,12 @@ type App struct {
 	// Storage keys
 	keyMain *sdk.KVStoreKey
 
-	paramsKeeper  params.Keeper
-	accountKeeper auth.AccountKeeper
+	paramsKeeper        params.Keeper
+	accountKeeper       auth.AccountKeeper
 	feeCollectionKeeper auth.FeeCollectionKeeper
+	bankKeeper          bank.Keeper
+	stakingKeeper       staking.Keeper
+	govKeeper           gov.Keeper
 
 	keeper tic_tac_toe.Keeper
 }
@@ -66,14 +72,54 @@ func NewApp(logger log.Logger, db db.DB) *App {
 	keyFeeCollection := sdk.NewKVStoreKey("fee_collection")
 	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(cdc, keyFeeCollection)
 
+	app.bankKeeper = bank.NewBaseKeeper(
+		app.accountKeeper,
+		app.paramsKeeper.Subspace(bank.DefaultParamspace),
+		bank.DefaultCodespace,
+	)
+
+	// Staking is only used to weigh governance votes
+	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
+	tkeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
+	app.stakingKeeper = staking.NewKeeper(
+		app.cdc,
+		keyStaking,
+		tkeyStaking,
+		app.bankKeeper,
+		app.paramsKeeper.Subspace(staking.DefaultParamspace),
+		staking.DefaultCodespace,
+	)
+
+	keyGov := sdk.NewKVStoreKey(gov.StoreKey)
+	app.govKeeper = gov.NewKeeper(
+		app.cdc,
+		keyGov,
+		app.paramsKeeper,
+		app.paramsKeeper.Subspace(gov.DefaultParamspace),
+		app.bankKeeper,
+		app.stakingKeeper,
+		gov.DefaultCodespace,
+	)
+
 	keyTicTacToe := sdk.NewKVStoreKey("tictactoe")
-	app.keeper = tic_tac_toe.NewKeeper(cdc, keyTicTacToe, app.accountKeeper)
+	app.keeper = tic_tac_toe.NewKeeper(
+		cdc,
+		keyTicTacToe,
+		app.accountKeeper,
+		app.govKeeper,
+		app.paramsKeeper.Subspace(tic_tac_toe.DefaultParamspace),
+	)
 
 	app.Router().
+		AddRoute("bank", bank.NewHandler(app.bankKeeper)).
+		AddRoute(staking.RouterKey, staking.NewHandler(app.stakingKeeper)).
+		AddRoute(gov.RouterKey, gov.NewHandler(app.govKeeper)).
 		AddRoute("tictactoe", tic_tac_toe.NewHandler(app.keeper))
 
 	app.QueryRouter().
 		AddRoute(auth.QuerierRoute, auth.NewQuerier(app.accountKeeper)).
+		AddRoute(staking.QuerierRoute, staking.NewQuerier(app.stakingKeeper, app.cdc)).
+		AddRoute(gov.QuerierRoute, gov.NewQuerier(app.govKeeper)).
 		AddRoute("tictactoe", tic_tac_toe.NewQuerier(app.keeper))
 
 	app.MountStores(
@@ -83,9 +129,16 @@ func NewApp(logger log.Logger, db db.DB) *App {
 		keyAccount,
 		keyTicTacToe,
 		keyFeeCollection,
+		keyStaking,
+		tkeyStaking,
+		keyGov,
 	)
 
+	// Move fees can be paid from gas allowances, everything else goes through the usual auth checks
+	app.SetAnteHandler(tic_tac_toe.NewAnteHandler(app.keeper, auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper)))
+
 	app.SetInitChainer(app.initChainer)
+	app.SetEndBlocker(app.endBlocker)
 
 	if err := app.LoadLatestVersion(app.keyMain); err != nil {
 		common.Exit(err.Error())
@@ -108,7 +161,16 @@ func (app *App) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 		panic(fmt.Sprintf("Invalid genesis auth state: %s", err))
 	}
 
+	if err := gov.ValidateGenesis(genesisState.GovState); err != nil {
+		panic(fmt.Sprintf("Invalid genesis gov state: %s", err))
+	}
+
+	if err := tic_tac_toe.ValidateGenesis(genesisState.TicTacToeState); err != nil {
+		panic(fmt.Sprintf("Invalid genesis tic tac toe state: %s", err))
+	}
+
 	auth.InitGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper, genesisState.AuthState)
+	bank.InitGenesis(ctx, app.bankKeeper, genesisState.BankState)
 
 	// Setting up initial accounts
 	accounts := make(map[string]bool)
@@ -123,29 +185,76 @@ func (app *App) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 
 		initialAccount.AccountNumber = app.accountKeeper.GetNextAccountNumber(ctx)
 		app.accountKeeper.SetAccount(ctx, initialAccount)
+
+		bondDenom := genesisState.StakingState.Params.BondDenom
+		notBonded := genesisState.StakingState.Pool.NotBondedTokens
+		genesisState.StakingState.Pool.NotBondedTokens = notBonded.Add(initialAccount.Coins.AmountOf(bondDenom))
+	}
+
+	validators, err := staking.InitGenesis(ctx, app.stakingKeeper, genesisState.StakingState)
+	if err != nil {
+		panic(fmt.Sprintf("Invalid genesis staking state: %s", err))
+	}
+
+	gov.InitGenesis(ctx, app.govKeeper, genesisState.GovState)
+	tic_tac_toe.InitGenesis(ctx, app.keeper, genesisState.TicTacToeState)
+
+	// Validators from the genesis file are used as long as nobody bonded through staking
+	if len(validators) == 0 {
+		validators = genesisState.Validators
 	}
 
 	initResponse := abci.ResponseInitChain{
-		Validators: genesisState.Validators,
+		Validators: validators,
 	}
 
 	return initResponse
 }
 
+func (app *App) endBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	tags := gov.EndBlocker(ctx, app.govKeeper)
+	tags = tags.AppendTags(tic_tac_toe.EndBlocker(ctx, app.keeper))
+
+	validatorUpdates, stakingTags := staking.EndBlocker(ctx, app.stakingKeeper)
+	tags = tags.AppendTags(stakingTags)
+
+	return abci.ResponseEndBlock{
+		ValidatorUpdates: validatorUpdates,
+		Tags:             tags,
+	}
+}
+
 
 // Uses go-amino which is a fork of protobuf3
 // Here the codec implementation is injected into different modules
 func MakeDefaultCodec() *codec.Codec {
 	var cdc = codec.New()
-	tic_tac_toe.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	gov.RegisterCodec(cdc)
+	tic_tac_toe.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	return cdc
 }
 
 type GenesisState struct {
-	AuthState     auth.GenesisState         `json:"auth"`
-	Accounts      []*auth.BaseAccount       `json:"accounts"`
-	Validators []abci.ValidatorUpdate `json:"validators"`
+	AuthState      auth.GenesisState        `json:"auth"`
+	BankState      bank.GenesisState        `json:"bank"`
+	StakingState   staking.GenesisState     `json:"staking"`
+	GovState       gov.GenesisState         `json:"gov"`
+	TicTacToeState tic_tac_toe.GenesisState `json:"tictactoe"`
+	Accounts       []*auth.BaseAccount      `json:"accounts"`
+	Validators     []abci.ValidatorUpdate   `json:"validators"`
+}
+
+func NewDefaultGenesisState() GenesisState {
+	return GenesisState{
+		AuthState:      auth.DefaultGenesisState(),
+		BankState:      bank.DefaultGenesisState(),
+		StakingState:   staking.DefaultGenesisState(),
+		GovState:       gov.DefaultGenesisState(),
+		TicTacToeState: tic_tac_toe.DefaultGenesisState(),
+	}
 }
\ No newline at end of file
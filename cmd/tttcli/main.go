@@ -26,6 +26,9 @@ import (
 	ticTacToeClient "tic_tac_toe/x/tic_tac_toe/client"
 	ticTacToeRest "tic_tac_toe/x/tic_tac_toe/client/rest"
 	authRest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	govRest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingClient "github.com/cosmos/cosmos-sdk/x/staking/client"
 )
 
 const (
@@ -48,9 +51,11 @@ func main() {
 	config.Seal()
 
 	tttModuleClient := ticTacToeClient.NewModuleClient(storeTicTacToe, cdc)
+	stakingModuleClient := stakingClient.NewModuleClient(staking.StoreKey, cdc)
 
 	mc := []sdkTypes.ModuleClients{
 		tttModuleClient,
+		stakingModuleClient,
 	}
 
 	rootCmd := &cobra.Command{
@@ -68,6 +73,9 @@ func main() {
 		client.ConfigCmd(defaultCLIHome),
 		queryCmd(cdc, mc),
 		txCmd(cdc, mc),
+		tttModuleClient.GetBotCmd(),
+		tttModuleClient.GetPlayCmd(),
+		tttModuleClient.GetEnginesCmd(),
 		client.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
 		keys.Commands(),
@@ -87,7 +95,9 @@ func registerRoutes(rs *lcd.RestServer) {
 	rpc.RegisterRoutes(rs.CliCtx, rs.Mux)
 	tx.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 	ticTacToeRest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	ticTacToeRest.RegisterWebApp(rs.CliCtx, rs.Mux, rs.Cdc)
 	authRest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, "acc")
+	govRest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 }
 
 func queryCmd(cdc *codec.Codec, mc []sdkTypes.ModuleClients) *cobra.Command {
@@ -121,6 +131,7 @@ func txCmd(cdc *amino.Codec, mc []sdkTypes.ModuleClients) *cobra.Command {
 		bankcli.SendTxCmd(cdc),
 		client.LineBreak,
 		authcli.GetSignCommand(cdc),
+		tx.GetBroadcastCommand(cdc),
 		client.LineBreak,
 	)
 
@@ -88,7 +88,7 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("genesis.json file already exists at path: %v", genesisFilePath)
 			}
 
-			appStateJSON, err = codec.MarshalJSONIndent(cdc, app.GenesisState{})
+			appStateJSON, err = codec.MarshalJSONIndent(cdc, app.NewDefaultGenesisState())
 			if err != nil {
 				return err
 			}
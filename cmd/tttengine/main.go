@@ -0,0 +1,56 @@
+// Reference engine speaking the engine protocol on stdin and stdout, it plays the solver's best move
+// unless -random makes it play a random free field now and then
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"tic_tac_toe/engine"
+	"tic_tac_toe/solver"
+)
+
+func main() {
+	name := flag.String("name", "tttengine", "name the engine reports")
+	random := flag.Int("random", 0, "percent of moves played on a random free field")
+	flag.Parse()
+
+	rand.Seed(time.Now().UnixNano())
+
+	think := func(variant string, game solver.Game, clock engine.Clock) (int, error) {
+		if variant != "" && variant != "classic" {
+			return 0, fmt.Errorf("Variant %s is not supported", variant)
+		}
+
+		var free []int
+		for cell, player := range game.Cells {
+			if player == 0 {
+				free = append(free, cell)
+			}
+		}
+
+		if len(free) == 0 {
+			return 0, fmt.Errorf("No free field")
+		}
+
+		if rand.Intn(100) < *random {
+			return free[rand.Intn(len(free))], nil
+		}
+
+		// A position with a line has no best move, a free field still answers it
+		move, err := solver.Best(game)
+		if err != nil {
+			return free[0], nil
+		}
+
+		return move.Field, nil
+	}
+
+	if err := engine.Serve(os.Stdin, os.Stdout, *name, "tic tac toe authors", think); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
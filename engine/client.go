@@ -0,0 +1,211 @@
+package engine
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"tic_tac_toe/solver"
+)
+
+// How long an engine has to answer anything but go
+const answerTimeout = 5 * time.Second
+
+// Engine is a running engine process
+type Engine struct {
+	Name    string
+	Author  string
+	Version int
+
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	lines chan string
+	// Lines starting with info, nil to drop them
+	Info func(text string)
+}
+
+// Start runs the command line of an engine and opens the session
+func Start(command string) (*Engine, error) {
+	words := strings.Fields(command)
+	if len(words) == 0 {
+		return nil, fmt.Errorf("Engine command is empty")
+	}
+
+	cmd := exec.Command(words[0], words[1:]...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	e := &Engine{cmd: cmd, stdin: stdin, lines: make(chan string, 16)}
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			e.lines <- scanner.Text()
+		}
+		close(e.lines)
+	}()
+
+	if err := e.handshake(); err != nil {
+		e.Kill()
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (e *Engine) send(lines ...string) error {
+	for _, line := range lines {
+		if _, err := io.WriteString(e.stdin, line+"\n"); err != nil {
+			return fmt.Errorf("Engine stopped reading: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// Waits for an answer, info lines are passed on and unknown ones skipped
+func (e *Engine) await(answer string, timeout time.Duration) ([]string, error) {
+	deadline := time.After(timeout)
+	for {
+		select {
+		case line, ok := <-e.lines:
+			if !ok {
+				return nil, fmt.Errorf("Engine exited waiting for %s", answer)
+			}
+
+			command, args := split(line)
+			switch command {
+			case answer:
+				return args, nil
+			case AnswerInfo:
+				if e.Info != nil {
+					e.Info(strings.Join(args, " "))
+				}
+			case AnswerId:
+				e.id(args)
+			}
+		case <-deadline:
+			return nil, fmt.Errorf("Engine did not answer %s within %s", answer, timeout)
+		}
+	}
+}
+
+func (e *Engine) id(args []string) {
+	if len(args) < 2 {
+		return
+	}
+
+	switch args[0] {
+	case "name":
+		e.Name = strings.Join(args[1:], " ")
+	case "author":
+		e.Author = strings.Join(args[1:], " ")
+	}
+}
+
+func (e *Engine) handshake() error {
+	if err := e.send(CmdTTT); err != nil {
+		return err
+	}
+
+	args, err := e.await(AnswerTTTOk, answerTimeout)
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		e.Version, _ = strconv.Atoi(args[0])
+	}
+
+	if e.Version > Version {
+		return fmt.Errorf("Engine speaks protocol %d, only %d is known", e.Version, Version)
+	}
+
+	return nil
+}
+
+// Ready waits until the engine handled every command sent before
+func (e *Engine) Ready() error {
+	if err := e.send(CmdIsReady); err != nil {
+		return err
+	}
+
+	_, err := e.await(AnswerReadyOk, answerTimeout)
+	return err
+}
+
+func (e *Engine) NewGame() error {
+	return e.send(CmdNewGame)
+}
+
+// Move asks for the move in a position, the engine has the movetime of the clock to answer or a
+// few seconds if it is 0
+func (e *Engine) Move(variant string, game solver.Game, clock Clock) (int, error) {
+	if err := e.send(CmdVariant+" "+variant, positionLine(game), clock.line(), CmdGo); err != nil {
+		return 0, err
+	}
+
+	timeout := answerTimeout
+	if clock.MoveTime > 0 {
+		timeout = time.Duration(clock.MoveTime)*time.Millisecond + time.Second
+	}
+
+	args, err := e.await(AnswerBestMove, timeout)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(args) == 0 {
+		return 0, fmt.Errorf("Engine sent bestmove without a field")
+	}
+
+	field, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, fmt.Errorf("Engine sent bestmove %s: %s", args[0], err)
+	}
+
+	if field < 0 || field >= len(game.Cells) || game.Cells[field] != 0 {
+		return 0, fmt.Errorf("Engine played field %d which is not free", field)
+	}
+
+	return field, nil
+}
+
+// Close asks the engine to quit and kills it if it does not
+func (e *Engine) Close() error {
+	e.send(CmdQuit)
+	e.stdin.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- e.cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(answerTimeout):
+		e.Kill()
+		return fmt.Errorf("Engine did not quit within %s", answerTimeout)
+	}
+}
+
+func (e *Engine) Kill() {
+	if e.cmd.Process != nil {
+		e.cmd.Process.Kill()
+	}
+}
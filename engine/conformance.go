@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"tic_tac_toe/solver"
+)
+
+// CheckResult is the outcome of one conformance check, Err is nil if the engine passed it
+type CheckResult struct {
+	Name string
+	Err  error
+}
+
+// Time an engine gets per move during the checks
+const checkMoveTime = 1000
+
+type check struct {
+	name string
+	run  func(e *Engine) error
+}
+
+// Positions every engine has to answer with a free field, including one with a single free field
+var checkPositions = []solver.Game{
+	solver.NewGame(3, 3),
+	{Size: 3, InARow: 3, Cells: []uint{1, 0, 0, 0, 2, 0, 0, 0, 0}, ToMove: 1},
+	{Size: 3, InARow: 3, Cells: []uint{1, 2, 1, 1, 2, 2, 2, 1, 0}, ToMove: 1},
+	{Size: 3, InARow: 3, Cells: []uint{2, 0, 0, 0, 1, 0, 0, 0, 1}, ToMove: 2},
+}
+
+var checks = []check{
+	{"answers isready", func(e *Engine) error {
+		return e.Ready()
+	}},
+	{"plays a free field", func(e *Engine) error {
+		for _, game := range checkPositions {
+			if err := e.NewGame(); err != nil {
+				return err
+			}
+
+			if _, err := e.Move("classic", game, Clock{MoveTime: checkMoveTime}); err != nil {
+				return fmt.Errorf("%s: %s", positionLine(game), err)
+			}
+		}
+
+		return nil
+	}},
+	{"answers within movetime", func(e *Engine) error {
+		start := time.Now()
+		if _, err := e.Move("classic", solver.NewGame(3, 3), Clock{MoveTime: checkMoveTime}); err != nil {
+			return err
+		}
+
+		if elapsed := time.Since(start); elapsed > checkMoveTime*time.Millisecond {
+			return fmt.Errorf("Took %s", elapsed)
+		}
+
+		return nil
+	}},
+	{"ignores unknown commands", func(e *Engine) error {
+		if err := e.send("nosuchcommand 1 2 3"); err != nil {
+			return err
+		}
+
+		return e.Ready()
+	}},
+	{"plays a whole game against itself", func(e *Engine) error {
+		if err := e.NewGame(); err != nil {
+			return err
+		}
+
+		game := solver.NewGame(3, 3)
+		for !game.Over() {
+			field, err := e.Move("classic", game, Clock{MoveTimeout: 10, BlocksLeft: 10, MoveTime: checkMoveTime})
+			if err != nil {
+				return err
+			}
+
+			game.Cells[field] = game.ToMove
+			game.ToMove = 3 - game.ToMove
+		}
+
+		return nil
+	}},
+}
+
+// Conformance runs an engine through the checks, the last result is whether it quits when asked
+func Conformance(command string) []CheckResult {
+	e, err := Start(command)
+	if err != nil {
+		return []CheckResult{{"opens a session with id and tttok", err}}
+	}
+
+	results := []CheckResult{{"opens a session with id and tttok", nil}}
+	if e.Name == "" {
+		results[0].Err = fmt.Errorf("Engine sent no id name")
+	}
+
+	for _, c := range checks {
+		results = append(results, CheckResult{c.name, c.run(e)})
+	}
+
+	return append(results, CheckResult{"quits", e.Close()})
+}
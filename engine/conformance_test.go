@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Builds the reference engine of cmd/tttengine, the tests are skipped without a go tool
+func buildEngine(t *testing.T) string {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool not found")
+	}
+
+	dir, err := ioutil.TempDir("", "tttengine")
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "tttengine")
+	out, err := exec.Command("go", "build", "-o", path, "../cmd/tttengine").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	return path
+}
+
+func TestConformance(t *testing.T) {
+	path := buildEngine(t)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	tests := []struct {
+		name string
+		args string
+	}{
+		{"solver", ""},
+		{"named", " -name sloppy"},
+		{"random moves", " -random 100"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			results := Conformance(path + tc.args)
+			require.Len(t, results, len(checks)+2)
+			for _, result := range results {
+				require.NoError(t, result.Err, result.Name)
+			}
+		})
+	}
+}
+
+func TestConformanceWithoutEngine(t *testing.T) {
+	results := Conformance("true")
+	require.Len(t, results, 1)
+	require.Error(t, results[0].Err)
+}
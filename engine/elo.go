@@ -0,0 +1,101 @@
+package engine
+
+import "math"
+
+// Result of one game for the first of two players, 1 for a win, 0.5 for a draw and 0 for a loss
+type Result struct {
+	Player1 int
+	Player2 int
+	Score   float64
+}
+
+// Rating is an Elo estimate with its 95% confidence interval
+type Rating struct {
+	Elo   float64
+	Lower float64
+	Upper float64
+	Score float64
+	Games int
+}
+
+// Scores of 0 or 1 have no finite Elo, they are clamped to this distance from the edge
+const eloClamp = 0.001
+
+func eloOfScore(score float64) float64 {
+	score = math.Max(eloClamp, math.Min(1-eloClamp, score))
+	return -400 * math.Log10(1/score-1)
+}
+
+// Ratings estimates the Elo of every player from their results, the ratings average 0. Each rating
+// moves halfway to the performance against the ratings of the opponents until they settle. The
+// interval is the Wilson interval of the player's score, so it stays open for a perfect score.
+func Ratings(players int, results []Result) []Rating {
+	ratings := make([]Rating, players)
+	scores := make([][]float64, players)
+	opponents := make([][]int, players)
+
+	for _, result := range results {
+		scores[result.Player1] = append(scores[result.Player1], result.Score)
+		scores[result.Player2] = append(scores[result.Player2], 1-result.Score)
+		opponents[result.Player1] = append(opponents[result.Player1], result.Player2)
+		opponents[result.Player2] = append(opponents[result.Player2], result.Player1)
+	}
+
+	for i := range ratings {
+		ratings[i].Games = len(scores[i])
+		ratings[i].Score = mean(scores[i])
+	}
+
+	for iteration := 0; iteration < 100; iteration++ {
+		next := make([]float64, players)
+		var total float64
+		for i := range ratings {
+			if ratings[i].Games == 0 {
+				continue
+			}
+
+			var opponentElo float64
+			for _, opponent := range opponents[i] {
+				opponentElo += ratings[opponent].Elo
+			}
+			performance := opponentElo/float64(len(opponents[i])) + eloOfScore(ratings[i].Score)
+			next[i] = (ratings[i].Elo + performance) / 2
+			total += next[i]
+		}
+
+		for i := range ratings {
+			ratings[i].Elo = next[i] - total/float64(players)
+		}
+	}
+
+	const z = 1.96
+	for i := range ratings {
+		n := float64(ratings[i].Games)
+		if n == 0 {
+			continue
+		}
+
+		p := ratings[i].Score
+		center := (p + z*z/(2*n)) / (1 + z*z/n)
+		margin := z / (1 + z*z/n) * math.Sqrt(p*(1-p)/n+z*z/(4*n*n))
+
+		base := ratings[i].Elo - eloOfScore(p)
+		ratings[i].Lower = base + eloOfScore(center-margin)
+		ratings[i].Upper = base + eloOfScore(center+margin)
+	}
+
+	return ratings
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
@@ -0,0 +1,143 @@
+// Package engine talks to tic tac toe engines running as separate processes, so an AI can be written
+// in any language and still play on chain through the bot.
+//
+// The protocol is line based. The client writes commands to the engine's stdin and reads answers from
+// its stdout, every line is a list of words separated by spaces.
+//
+// Client to engine:
+//
+//	ttt                                  starts the session, the engine answers with its id lines and tttok
+//	isready                              the engine answers readyok once it handled everything before
+//	newgame                              the next positions belong to a new game
+//	variant <name>                       rules of the next positions, only classic so far
+//	position <size> <in_a_row> <cells> <to_move>
+//	                                     board of size*size cells row by row, 0 for empty or the player
+//	                                     number, and the player to move, 1 or 2
+//	clock <move_timeout> <blocks_left> <movetime>
+//	                                     blocks the chain gives per move and until the deadline, 0 for no
+//	                                     limit, and the milliseconds the client waits for the answer
+//	go                                   the engine answers bestmove for the last position
+//	quit                                 the engine exits
+//
+// Engine to client:
+//
+//	id name <name>
+//	id author <author>
+//	tttok <version>                      ends the answer to ttt, version is the protocol version
+//	readyok
+//	bestmove <field>                     field numbered from 0 row by row
+//	info <text>                          anything the engine wants to show, clients only log it
+//
+// Unknown commands are ignored by the engine, unknown answers by the client, so both sides can add to
+// the protocol without breaking the other.
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"tic_tac_toe/solver"
+)
+
+// Version of the protocol spoken by this package
+const Version = 1
+
+const (
+	CmdTTT      = "ttt"
+	CmdIsReady  = "isready"
+	CmdNewGame  = "newgame"
+	CmdVariant  = "variant"
+	CmdPosition = "position"
+	CmdClock    = "clock"
+	CmdGo       = "go"
+	CmdQuit     = "quit"
+
+	AnswerId       = "id"
+	AnswerTTTOk    = "tttok"
+	AnswerReadyOk  = "readyok"
+	AnswerBestMove = "bestmove"
+	AnswerInfo     = "info"
+)
+
+// Clock is the time there is for a move, zero values mean no limit
+type Clock struct {
+	MoveTimeout int64 `json:"move_timeout"`
+	BlocksLeft  int64 `json:"blocks_left"`
+	MoveTime    int64 `json:"movetime"`
+}
+
+func (clock Clock) line() string {
+	return fmt.Sprintf("%s %d %d %d", CmdClock, clock.MoveTimeout, clock.BlocksLeft, clock.MoveTime)
+}
+
+func parseClock(words []string) (Clock, error) {
+	if len(words) != 3 {
+		return Clock{}, fmt.Errorf("Clock needs 3 values, has %d", len(words))
+	}
+
+	var values [3]int64
+	for i, word := range words {
+		value, err := strconv.ParseInt(word, 10, 64)
+		if err != nil {
+			return Clock{}, err
+		}
+		values[i] = value
+	}
+
+	return Clock{MoveTimeout: values[0], BlocksLeft: values[1], MoveTime: values[2]}, nil
+}
+
+func positionLine(game solver.Game) string {
+	cells := make([]byte, len(game.Cells))
+	for i, player := range game.Cells {
+		cells[i] = byte('0' + player)
+	}
+
+	return fmt.Sprintf("%s %d %d %s %d", CmdPosition, game.Size, game.InARow, cells, game.ToMove)
+}
+
+func parsePosition(words []string) (solver.Game, error) {
+	if len(words) != 4 {
+		return solver.Game{}, fmt.Errorf("Position needs 4 values, has %d", len(words))
+	}
+
+	size, err := strconv.Atoi(words[0])
+	if err != nil {
+		return solver.Game{}, err
+	}
+
+	inARow, err := strconv.Atoi(words[1])
+	if err != nil {
+		return solver.Game{}, err
+	}
+
+	toMove, err := strconv.Atoi(words[3])
+	if err != nil {
+		return solver.Game{}, err
+	}
+
+	game := solver.Game{Size: size, InARow: inARow, ToMove: uint(toMove)}
+	for _, c := range words[2] {
+		if c < '0' || c > '2' {
+			return solver.Game{}, fmt.Errorf("Cell %q has to be 0, 1 or 2", c)
+		}
+		game.Cells = append(game.Cells, uint(c-'0'))
+	}
+
+	if len(game.Cells) != size*size {
+		return solver.Game{}, fmt.Errorf("Board of %d needs %d cells, has %d", size, size*size, len(game.Cells))
+	}
+
+	return game, nil
+}
+
+// Splits a line into its command and arguments
+func split(line string) (string, []string) {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return "", nil
+	}
+
+	return words[0], words[1:]
+}
@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"tic_tac_toe/solver"
+)
+
+// Think picks the field to play in a position
+type Think func(variant string, game solver.Game, clock Clock) (int, error)
+
+// Serve is the engine side of the protocol, it answers the commands read from in until quit or the
+// end of the input
+func Serve(in io.Reader, out io.Writer, name, author string, think Think) error {
+	var variant string
+	var game solver.Game
+	var clock Clock
+	var position bool
+
+	answer := func(format string, args ...interface{}) error {
+		_, err := fmt.Fprintf(out, format+"\n", args...)
+		return err
+	}
+
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		command, args := split(scanner.Text())
+
+		var err error
+		switch command {
+		case CmdTTT:
+			if err = answer("%s name %s", AnswerId, name); err == nil {
+				if err = answer("%s author %s", AnswerId, author); err == nil {
+					err = answer("%s %d", AnswerTTTOk, Version)
+				}
+			}
+		case CmdIsReady:
+			err = answer(AnswerReadyOk)
+		case CmdNewGame:
+			variant, clock, position = "", Clock{}, false
+		case CmdVariant:
+			if len(args) > 0 {
+				variant = args[0]
+			}
+		case CmdPosition:
+			if game, err = parsePosition(args); err != nil {
+				err = answer("%s bad position: %s", AnswerInfo, err)
+			} else {
+				position = true
+			}
+		case CmdClock:
+			if clock, err = parseClock(args); err != nil {
+				err = answer("%s bad clock: %s", AnswerInfo, err)
+			}
+		case CmdGo:
+			if !position {
+				err = answer("%s go without a position", AnswerInfo)
+				break
+			}
+
+			field, thinkErr := think(variant, game, clock)
+			if thinkErr != nil {
+				err = answer("%s %s", AnswerInfo, thinkErr)
+				break
+			}
+
+			err = answer("%s %d", AnswerBestMove, field)
+		case CmdQuit:
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
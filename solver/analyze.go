@@ -0,0 +1,85 @@
+package solver
+
+import "fmt"
+
+// How a move compares to the best move in its position
+type Class string
+
+const (
+	ClassBest Class = "best"
+	// Keeps the outcome but wins slower or loses faster than it had to
+	ClassInaccuracy Class = "inaccuracy"
+	// Changes the outcome for the worse
+	ClassBlunder Class = "blunder"
+	// The position could not be rated, the search did not reach the end of the game
+	ClassUnrated Class = "unrated"
+)
+
+// Annotation rates one move of a game, Cells is the board after it
+type Annotation struct {
+	Ply    int    `json:"ply"`
+	Player uint   `json:"player"`
+	Field  int    `json:"field"`
+	Class  Class  `json:"class"`
+	Played Move   `json:"played"`
+	Best   []Move `json:"best"`
+	Cells  []uint `json:"cells"`
+}
+
+// Analyze replays the moves in turns from an empty board, starting with the given player, and rates
+// each of them against the best moves of its position
+func Analyze(size, inARow int, first uint, moves []int) ([]Annotation, error) {
+	game := NewGame(size, inARow)
+	game.ToMove = first
+	if err := game.validate(); err != nil {
+		return nil, err
+	}
+
+	var annotations []Annotation
+	for ply, field := range moves {
+		if field < 0 || field >= len(game.Cells) || game.Cells[field] != 0 {
+			return nil, fmt.Errorf("Move %d to field %d is not legal", ply+1, field)
+		}
+
+		if game.Over() {
+			return nil, fmt.Errorf("Move %d is played after the game is over", ply+1)
+		}
+
+		evaluated, err := Evaluate(game)
+		if err != nil {
+			return nil, err
+		}
+
+		annotation := Annotation{Ply: ply + 1, Player: game.ToMove, Field: field}
+		for _, move := range evaluated {
+			if move.Field == field {
+				annotation.Played = move
+			}
+
+			if rank(move) == rank(evaluated[0]) {
+				annotation.Best = append(annotation.Best, move)
+			}
+		}
+
+		annotation.Class = classify(annotation.Played, evaluated[0])
+
+		game = game.play(field)
+		annotation.Cells = game.Cells
+		annotations = append(annotations, annotation)
+	}
+
+	return annotations, nil
+}
+
+func classify(played, best Move) Class {
+	switch {
+	case played.Outcome == Unknown || best.Outcome == Unknown:
+		return ClassUnrated
+	case played.Outcome != best.Outcome:
+		return ClassBlunder
+	case rank(played) != rank(best):
+		return ClassInaccuracy
+	default:
+		return ClassBest
+	}
+}
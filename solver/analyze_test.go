@@ -0,0 +1,60 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name    string
+		first   uint
+		moves   []int
+		classes []Class
+		err     bool
+	}{
+		{
+			name:    "edge answer to the centre loses",
+			first:   1,
+			moves:   []int{4, 1},
+			classes: []Class{ClassBest, ClassBlunder},
+		},
+		{
+			name:    "player 2 moves first and wins on the diagonal",
+			first:   2,
+			moves:   []int{0, 1, 4, 2, 8},
+			classes: []Class{ClassBest, ClassBlunder, ClassBest, ClassInaccuracy, ClassBest},
+		},
+		{
+			name:  "move after the game is over",
+			first: 2,
+			moves: []int{0, 1, 4, 2, 8, 3},
+			err:   true,
+		},
+		{
+			name:  "field taken",
+			first: 1,
+			moves: []int{4, 4},
+			err:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			annotations, err := Analyze(3, 3, tc.first, tc.moves)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var classes []Class
+			for _, annotation := range annotations {
+				classes = append(classes, annotation.Class)
+			}
+			require.Equal(t, tc.classes, classes)
+			require.Equal(t, tc.first, annotations[0].Player)
+		})
+	}
+}
@@ -0,0 +1,382 @@
+// Package solver finds good moves for tic tac toe positions. It knows nothing about the chain, so bots
+// and clients can use it on any board: 3x3 is solved outright, larger boards are searched to a fixed
+// depth with a heuristic once they have too many empty cells to solve.
+package solver
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Outcome of a move for the player who plays it, with best play from both sides
+type Outcome string
+
+const (
+	Win  Outcome = "win"
+	Draw Outcome = "draw"
+	Loss Outcome = "loss"
+	// The search did not reach the end of the game
+	Unknown Outcome = "unknown"
+)
+
+// Positions with at most this many empty cells are solved, others are searched to searchDepth
+const (
+	solveLimit  = 10
+	searchDepth = 4
+)
+
+// Game is a position on a square board, cells are numbered row by row from 0 and hold 0 for an
+// empty cell or the number of the player who marked it
+type Game struct {
+	Size   int    `json:"size"`
+	InARow int    `json:"in_a_row"`
+	Cells  []uint `json:"cells"`
+	// Player to move, 1 or 2
+	ToMove uint `json:"to_move"`
+}
+
+// NewGame is an empty board with player 1 to move
+func NewGame(size, inARow int) Game {
+	return Game{Size: size, InARow: inARow, Cells: make([]uint, size*size), ToMove: 1}
+}
+
+// FromFields reads a 3x3 board keyed by the field number as the chain stores it
+func FromFields(fields map[string]uint, toMove uint) Game {
+	game := NewGame(3, 3)
+	for i := range game.Cells {
+		game.Cells[i] = fields[strconv.Itoa(i)]
+	}
+	game.ToMove = toMove
+
+	return game
+}
+
+// Move is a cell with what playing it leads to
+type Move struct {
+	Field   int     `json:"field"`
+	Outcome Outcome `json:"outcome"`
+	// Moves until the game is decided counting this one, 0 if the outcome is unknown
+	Depth int `json:"depth"`
+	// Heuristic value for the mover when the outcome is unknown, higher is better
+	Score int `json:"score"`
+}
+
+func (game Game) validate() error {
+	if game.Size < 1 || game.InARow < 1 || game.InARow > game.Size {
+		return fmt.Errorf("Board of %d with %d in a row is not playable", game.Size, game.InARow)
+	}
+
+	if len(game.Cells) != game.Size*game.Size {
+		return fmt.Errorf("Board of %d needs %d cells, has %d", game.Size, game.Size*game.Size, len(game.Cells))
+	}
+
+	if game.ToMove != 1 && game.ToMove != 2 {
+		return fmt.Errorf("Player to move has to be 1 or 2")
+	}
+
+	return nil
+}
+
+// Winner is the player with a line, 0 if there is none
+func (game Game) Winner() uint {
+	for _, line := range Lines(game.Size, game.InARow) {
+		first := game.Cells[line[0]]
+		if first == 0 {
+			continue
+		}
+
+		won := true
+		for _, cell := range line[1:] {
+			if game.Cells[cell] != first {
+				won = false
+				break
+			}
+		}
+
+		if won {
+			return first
+		}
+	}
+
+	return 0
+}
+
+// Over tells whether somebody has a line or the board is full
+func (game Game) Over() bool {
+	return game.Winner() != 0 || len(game.free()) == 0
+}
+
+func (game Game) free() []int {
+	var free []int
+	for cell, player := range game.Cells {
+		if player == 0 {
+			free = append(free, cell)
+		}
+	}
+
+	return free
+}
+
+func (game Game) play(cell int) Game {
+	next := Game{Size: game.Size, InARow: game.InARow, Cells: make([]uint, len(game.Cells)), ToMove: other(game.ToMove)}
+	copy(next.Cells, game.Cells)
+	next.Cells[cell] = game.ToMove
+
+	return next
+}
+
+func other(player uint) uint {
+	if player == 1 {
+		return 2
+	}
+
+	return 1
+}
+
+var linesCache = struct {
+	sync.Mutex
+	lines map[[2]int][][]int
+}{lines: make(map[[2]int][][]int)}
+
+// Lines are the runs of inARow cells in a row, column or diagonal which win the game. The chain
+// checks its games with the same table, the result is shared and must not be changed.
+func Lines(size, inARow int) [][]int {
+	linesCache.Lock()
+	defer linesCache.Unlock()
+
+	if cached, ok := linesCache.lines[[2]int{size, inARow}]; ok {
+		return cached
+	}
+
+	var result [][]int
+	directions := [][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			for _, d := range directions {
+				endRow, endCol := row+d[0]*(inARow-1), col+d[1]*(inARow-1)
+				if endRow < 0 || endRow >= size || endCol < 0 || endCol >= size {
+					continue
+				}
+
+				line := make([]int, inARow)
+				for i := range line {
+					line[i] = (row+d[0]*i)*size + col + d[1]*i
+				}
+				result = append(result, line)
+			}
+		}
+	}
+
+	linesCache.lines[[2]int{size, inARow}] = result
+
+	return result
+}
+
+// Evaluate rates every legal move of the player to move, the best ones first. Equally good moves
+// keep the order of their fields.
+func Evaluate(game Game) ([]Move, error) {
+	if err := game.validate(); err != nil {
+		return nil, err
+	}
+
+	if game.Over() {
+		return nil, fmt.Errorf("Game is over")
+	}
+
+	s := newSearch()
+	exact := len(game.free()) <= solveLimit || game.Size <= 3
+
+	var moves []Move
+	for _, cell := range game.free() {
+		next := game.play(cell)
+
+		var move Move
+		if exact {
+			outcome, depth := s.solve(next)
+			move = Move{Field: cell, Outcome: flip(outcome), Depth: depth + 1}
+		} else {
+			move = Move{Field: cell, Outcome: Unknown, Score: -s.search(next, searchDepth-1, -infinity, infinity)}
+			if next.Winner() != 0 {
+				move.Outcome, move.Depth = Win, 1
+			}
+		}
+
+		moves = append(moves, move)
+	}
+
+	sort.SliceStable(moves, func(i, j int) bool {
+		return better(moves[i], moves[j])
+	})
+
+	return moves, nil
+}
+
+// Best is the first of the best moves
+func Best(game Game) (Move, error) {
+	moves, err := Evaluate(game)
+	if err != nil {
+		return Move{}, err
+	}
+
+	return moves[0], nil
+}
+
+// Value of an outcome for ranking, faster wins and slower losses rank higher
+func rank(move Move) int {
+	switch move.Outcome {
+	case Win:
+		return 2*infinity - move.Depth
+	case Loss:
+		return -2*infinity + move.Depth
+	case Draw:
+		return 0
+	default:
+		return move.Score
+	}
+}
+
+func better(a, b Move) bool {
+	return rank(a) > rank(b)
+}
+
+func flip(outcome Outcome) Outcome {
+	switch outcome {
+	case Win:
+		return Loss
+	case Loss:
+		return Win
+	default:
+		return outcome
+	}
+}
+
+const infinity = 1 << 20
+
+type solved struct {
+	outcome Outcome
+	depth   int
+}
+
+type search struct {
+	solved map[string]solved
+}
+
+func newSearch() *search {
+	return &search{solved: make(map[string]solved)}
+}
+
+func positionKey(game Game) string {
+	key := make([]byte, len(game.Cells)+1)
+	for i, player := range game.Cells {
+		key[i] = byte('0' + player)
+	}
+	key[len(game.Cells)] = byte('0' + game.ToMove)
+
+	return string(key)
+}
+
+// Outcome for the player to move and the moves until it is decided
+func (s *search) solve(game Game) (Outcome, int) {
+	if winner := game.Winner(); winner != 0 {
+		if winner == game.ToMove {
+			return Win, 0
+		}
+
+		return Loss, 0
+	}
+
+	free := game.free()
+	if len(free) == 0 {
+		return Draw, 0
+	}
+
+	key := positionKey(game)
+	if known, ok := s.solved[key]; ok {
+		return known.outcome, known.depth
+	}
+
+	var best Move
+	for i, cell := range free {
+		outcome, depth := s.solve(game.play(cell))
+		move := Move{Field: cell, Outcome: flip(outcome), Depth: depth + 1}
+		if i == 0 || better(move, best) {
+			best = move
+		}
+	}
+
+	s.solved[key] = solved{best.Outcome, best.Depth}
+
+	return best.Outcome, best.Depth
+}
+
+// Negamax with alpha-beta pruning, positions at the depth limit are rated by heuristic
+func (s *search) search(game Game, depth, alpha, beta int) int {
+	if winner := game.Winner(); winner != 0 {
+		if winner == game.ToMove {
+			return infinity - (searchDepth - depth)
+		}
+
+		return -infinity + (searchDepth - depth)
+	}
+
+	free := game.free()
+	if len(free) == 0 {
+		return 0
+	}
+
+	if depth == 0 {
+		return heuristic(game)
+	}
+
+	best := -infinity - 1
+	for _, cell := range free {
+		score := -s.search(game.play(cell), depth-1, -beta, -alpha)
+		if score > best {
+			best = score
+		}
+		if best > alpha {
+			alpha = best
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+
+	return best
+}
+
+// Lines still open to only one player count for them, more marks in a line count much more
+func heuristic(game Game) int {
+	score := 0
+	for _, line := range Lines(game.Size, game.InARow) {
+		var mine, theirs int
+		for _, cell := range line {
+			switch game.Cells[cell] {
+			case game.ToMove:
+				mine++
+			case other(game.ToMove):
+				theirs++
+			}
+		}
+
+		switch {
+		case theirs == 0 && mine > 0:
+			score += weight(mine)
+		case mine == 0 && theirs > 0:
+			score -= weight(theirs)
+		}
+	}
+
+	return score
+}
+
+func weight(marks int) int {
+	w := 1
+	for i := 1; i < marks; i++ {
+		w *= 10
+	}
+
+	return w
+}
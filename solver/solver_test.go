@@ -0,0 +1,83 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// A 3x3 board with the given player to move, cells left out are empty
+func testGame(toMove uint, cells ...uint) Game {
+	game := NewGame(3, 3)
+	copy(game.Cells, cells)
+	game.ToMove = toMove
+
+	return game
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		size   int
+		inARow int
+		lines  int
+	}{
+		{3, 3, 8},
+		{4, 3, 24},
+		{4, 4, 10},
+		{5, 4, 28},
+	}
+
+	for _, tc := range tests {
+		require.Len(t, Lines(tc.size, tc.inARow), tc.lines, "%d in a row on %d", tc.inARow, tc.size)
+	}
+
+	require.Contains(t, Lines(3, 3), []int{0, 4, 8})
+	require.Contains(t, Lines(3, 3), []int{2, 4, 6})
+}
+
+func TestWinner(t *testing.T) {
+	tests := []struct {
+		name   string
+		game   Game
+		winner uint
+	}{
+		{"empty", testGame(1), 0},
+		{"row", testGame(2, 1, 1, 1, 2, 2), 1},
+		{"column", testGame(1, 2, 1, 0, 2, 1, 0, 2), 2},
+		{"diagonal", testGame(2, 1, 2, 0, 2, 1, 0, 0, 0, 1), 1},
+		{"anti-diagonal", testGame(1, 1, 1, 2, 0, 2, 0, 2, 1), 2},
+		{"full without a line", testGame(2, 1, 2, 1, 1, 2, 2, 2, 1, 1), 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.winner, tc.game.Winner())
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name    string
+		game    Game
+		best    int
+		outcome Outcome
+		depth   int
+	}{
+		{"empty board is a draw", testGame(1), 0, Draw, 9},
+		{"wins at once", testGame(1, 1, 1, 0, 2, 2), 2, Win, 1},
+		{"edge against opposite corners", testGame(2, 1, 0, 0, 0, 2, 0, 0, 0, 1), 1, Draw, 6},
+		{"every move loses to the fork", testGame(2, 1, 2, 0, 1, 1), 2, Loss, 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			best, err := Best(tc.game)
+			require.NoError(t, err)
+			require.Equal(t, Move{Field: tc.best, Outcome: tc.outcome, Depth: tc.depth}, best)
+		})
+	}
+
+	_, err := Evaluate(testGame(2, 1, 1, 1, 2, 2))
+	require.Error(t, err)
+}
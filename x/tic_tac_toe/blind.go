@@ -0,0 +1,294 @@
+package tic_tac_toe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// In blind games both players commit to a field every round and reveal afterwards. The variant
+// declares what happens when both pick the same field.
+const (
+	// The field stays empty and both players lose their move
+	VariantBlind = "blind"
+	// The player who committed first gets the field, the other one picks again
+	VariantBlindFirstCommit = "blind_first_commit"
+)
+
+// A short salt would let the other player try all fields and salts against the commitment
+const BlindSaltLength = 16
+
+func isBlindVariant(variant string) bool {
+	return variant == VariantBlind || variant == VariantBlindFirstCommit
+}
+
+type BlindMove struct {
+	Commitment []byte `json:"commitment"`
+	// 1 for the first commitment of the round
+	Order    uint `json:"order"`
+	Revealed bool `json:"revealed"`
+	Field    uint `json:"field"`
+	// The move already went through this round and the other player is picking again
+	Placed bool `json:"placed"`
+}
+
+// BlindRound holds the moves of the round being played, a nil move has not been committed yet
+type BlindRound struct {
+	GameId  uint       `json:"game_id"`
+	Round   uint       `json:"round"`
+	Commits uint       `json:"commits"`
+	Move1   *BlindMove `json:"move_1"`
+	Move2   *BlindMove `json:"move_2"`
+}
+
+func (round *BlindRound) move(player uint) **BlindMove {
+	if player == 1 {
+		return &round.Move1
+	}
+
+	return &round.Move2
+}
+
+func (round BlindRound) revealing() bool {
+	return round.Move1 != nil && round.Move2 != nil
+}
+
+func (round BlindRound) done(move *BlindMove) bool {
+	return move != nil && (move.Revealed || move.Placed)
+}
+
+// The commitment covers the game and round so it can not be replayed, and a salt so the field can not be guessed
+func BlindCommitment(player sdk.AccAddress, gameID, round, field uint, salt []byte) []byte {
+	bz := append([]byte{}, player...)
+	bz = append(bz, uint64Bytes(uint64(gameID))...)
+	bz = append(bz, uint64Bytes(uint64(round))...)
+	bz = append(bz, byte(field))
+	bz = append(bz, salt...)
+
+	return tmhash.Sum(bz)
+}
+
+func uint64Bytes(n uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, n)
+	return bz
+}
+
+func blindRoundKey(gameID uint) []byte {
+	return []byte(fmt.Sprintf("blind:%d", gameID))
+}
+
+func (k Keeper) storeBlindRound(ctx sdk.Context, round *BlindRound) {
+	store := ctx.KVStore(k.key)
+	store.Set(blindRoundKey(round.GameId), k.cdc.MustMarshalJSON(round))
+}
+
+func (k Keeper) getBlindRound(ctx sdk.Context, gameID uint) *BlindRound {
+	store := ctx.KVStore(k.key)
+	value := store.Get(blindRoundKey(gameID))
+	if value == nil {
+		return &BlindRound{GameId: gameID, Round: 1}
+	}
+
+	round := new(BlindRound)
+	if err := k.cdc.UnmarshalJSON(value, round); err != nil {
+		panic(fmt.Sprintf("Invalid blind round stored: %s", err))
+	}
+
+	return round
+}
+
+func (k Keeper) deleteBlindRound(ctx sdk.Context, gameID uint) {
+	store := ctx.KVStore(k.key)
+	store.Delete(blindRoundKey(gameID))
+}
+
+// Reveals have their own deadline, commits are limited by the move timeout like normal moves
+func (k Keeper) blindRevealing(ctx sdk.Context, game *Game) bool {
+	return isBlindVariant(game.Variant) && game.Winner == WinnerNone && k.getBlindRound(ctx, game.Id).revealing()
+}
+
+func (k Keeper) getBlindGame(ctx sdk.Context, gameID uint, player sdk.AccAddress) (*Game, uint, sdk.Error) {
+	game := k.getGame(ctx, gameID)
+	if game == nil {
+		return nil, 0, sdk.ErrUnknownRequest("No such game")
+	}
+
+	if !isBlindVariant(game.Variant) {
+		return nil, 0, sdk.ErrUnknownRequest("Not a blind game")
+	}
+
+	if game.Winner != WinnerNone {
+		return nil, 0, sdk.ErrUnknownRequest("Game already finished")
+	}
+
+	if game.AwaitingAccept {
+		return nil, 0, sdk.ErrUnknownRequest("The invite is not accepted yet")
+	}
+
+	if game.FirstPlayer == 0 {
+		return nil, 0, sdk.ErrUnknownRequest("The toss is not decided yet")
+	}
+
+	switch {
+	case game.Player1.Equals(player):
+		return game, 1, nil
+	case game.Player2.Equals(player):
+		return game, 2, nil
+	default:
+		return nil, 0, sdk.ErrUnauthorized("Not playing in this game")
+	}
+}
+
+func (k Keeper) CommitMove(ctx sdk.Context, gameID uint, player sdk.AccAddress, commitment []byte) sdk.Result {
+	game, number, err := k.getBlindGame(ctx, gameID, player)
+	if err != nil {
+		return err.Result()
+	}
+
+	round := k.getBlindRound(ctx, game.Id)
+	move := round.move(number)
+	if *move != nil {
+		return sdk.ErrUnknownRequest("Already committed this round").Result()
+	}
+
+	round.Commits++
+	*move = &BlindMove{Commitment: commitment, Order: round.Commits}
+	k.storeBlindRound(ctx, round)
+
+	if round.revealing() {
+		k.resetDeadline(ctx, game)
+		k.storeGame(ctx, game)
+	}
+
+	return sdk.Result{Tags: gameTags(game).AppendTag(TagBlindRound, strconv.Itoa(int(round.Round)))}
+}
+
+func (k Keeper) RevealMove(ctx sdk.Context, gameID uint, player sdk.AccAddress, field uint, salt []byte) sdk.Result {
+	game, number, err := k.getBlindGame(ctx, gameID, player)
+	if err != nil {
+		return err.Result()
+	}
+
+	round := k.getBlindRound(ctx, game.Id)
+	if !round.revealing() {
+		return sdk.ErrUnknownRequest("Both players have to commit first").Result()
+	}
+
+	move := *round.move(number)
+	if round.done(move) {
+		return sdk.ErrUnknownRequest("Already revealed this round").Result()
+	}
+
+	if !bytes.Equal(BlindCommitment(player, game.Id, round.Round, field, salt), move.Commitment) {
+		return sdk.ErrUnauthorized("Field and salt do not match the commitment").Result()
+	}
+
+	move.Revealed = true
+	move.Field = field
+
+	var resTags sdk.Tags
+	if round.done(round.Move1) && round.done(round.Move2) {
+		resTags = k.resolveBlindRound(ctx, game, round)
+	} else {
+		k.storeBlindRound(ctx, round)
+	}
+
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: gameTags(game).AppendTags(resTags)}
+}
+
+// Places the revealed moves and starts the next round. A move to a taken field is lost.
+func (k Keeper) resolveBlindRound(ctx sdk.Context, game *Game, round *BlindRound) sdk.Tags {
+	resTags := sdk.NewTags(TagBlindRound, strconv.Itoa(int(round.Round)))
+
+	place := func(player uint, move *BlindMove) {
+		fieldStr := strconv.Itoa(int(move.Field))
+		if !isFieldTaken(game.Fields, fieldStr) {
+			game.Fields[fieldStr] = player
+			game.Moves = append(game.Moves, move.Field)
+		}
+	}
+
+	move1, move2 := round.Move1, round.Move2
+	var repick uint
+
+	switch {
+	case move1.Placed:
+		place(2, move2)
+	case move2.Placed:
+		place(1, move1)
+	case move1.Field != move2.Field:
+		place(1, move1)
+		place(2, move2)
+	case game.Variant == VariantBlindFirstCommit:
+		resTags = resTags.AppendTag(TagBlindCollision, strconv.Itoa(int(move1.Field)))
+
+		first, firstMove := uint(1), move1
+		if move2.Order < move1.Order {
+			first, firstMove = 2, move2
+		}
+
+		place(first, firstMove)
+		repick = otherPlayer(first)
+	default:
+		resTags = resTags.AppendTag(TagBlindCollision, strconv.Itoa(int(move1.Field)))
+	}
+
+	won1, won2 := hasLine(game.Fields, 1), hasLine(game.Fields, 2)
+	switch {
+	case won1 && won2:
+		game.Winner = WinnerDraw
+	case won1:
+		game.Winner = 1
+	case won2:
+		game.Winner = 2
+	case totalMoves(game.Fields) == len(game.Fields):
+		game.Winner = WinnerDraw
+	}
+
+	if game.Winner != WinnerNone {
+		k.deleteBlindRound(ctx, game.Id)
+		return resTags.AppendTags(k.finishGame(ctx, game))
+	}
+
+	// After a first commit collision the round goes on until the other player found a free field
+	if repick != 0 {
+		(*round.move(otherPlayer(repick))).Placed = true
+		*round.move(repick) = nil
+	} else {
+		*round = BlindRound{GameId: game.Id, Round: round.Round + 1}
+	}
+
+	k.storeBlindRound(ctx, round)
+	k.resetDeadline(ctx, game)
+
+	return resTags
+}
+
+// Players who did not commit or reveal in time lose, if both failed the game is a draw
+func (k Keeper) blindForfeitWinner(ctx sdk.Context, game *Game) uint {
+	round := k.getBlindRound(ctx, game.Id)
+	k.deleteBlindRound(ctx, game.Id)
+
+	var failed1, failed2 bool
+	if round.revealing() {
+		failed1, failed2 = !round.done(round.Move1), !round.done(round.Move2)
+	} else {
+		failed1, failed2 = round.Move1 == nil, round.Move2 == nil
+	}
+
+	switch {
+	case failed1 && !failed2:
+		return 2
+	case failed2 && !failed1:
+		return 1
+	default:
+		return WinnerDraw
+	}
+}
@@ -0,0 +1,138 @@
+package tic_tac_toe
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// A board from the marks of its fields row by row, fields left out are free
+func testFields(marks ...uint) map[string]uint {
+	fields := emptyFields()
+	for i, mark := range marks {
+		fields[string('0'+rune(i))] = mark
+	}
+
+	return fields
+}
+
+func TestWinnerOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]uint
+		winner uint
+	}{
+		{"empty", testFields(), WinnerNone},
+		{"top row", testFields(1, 1, 1, 2, 2, 0, 0, 0, 0), 1},
+		{"middle row", testFields(1, 0, 1, 2, 2, 2, 1, 0, 0), 2},
+		{"bottom row", testFields(2, 2, 0, 0, 0, 0, 1, 1, 1), 1},
+		{"left column", testFields(2, 1, 0, 2, 1, 0, 2, 0, 1), 2},
+		{"middle column", testFields(2, 1, 0, 0, 1, 2, 0, 1, 0), 1},
+		{"right column", testFields(1, 1, 2, 0, 0, 2, 1, 0, 2), 2},
+		{"diagonal", testFields(1, 2, 0, 2, 1, 0, 0, 0, 1), 1},
+		{"anti-diagonal", testFields(1, 1, 2, 0, 2, 0, 2, 1, 0), 2},
+		{"full board", testFields(1, 2, 1, 1, 2, 2, 2, 1, 1), WinnerDraw},
+		{"open", testFields(1, 2, 1, 0, 0, 0, 0, 0, 0), WinnerNone},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.winner, WinnerOf(tc.fields))
+		})
+	}
+}
+
+func createBlindGame(t *testing.T, input testInput, variant string) (*Game, [2]sdk.AccAddress) {
+	p := DefaultParams()
+	p.EnabledVariants = []string{VariantClassic, VariantBlind, VariantBlindFirstCommit}
+	input.k.SetParams(input.ctx, p)
+
+	players := [2]sdk.AccAddress{input.fund(t, "alice", "100abc"), input.fund(t, "bob", "100abc")}
+	game, res := input.k.InviteGame(input.ctx, players[0], players[1], variant, sdk.Coins{}, sdk.Coins{}, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.AcceptGame(input.ctx, game.Id, players[1]).IsOK())
+
+	return game, players
+}
+
+func commitBlind(t *testing.T, input testInput, gameID uint, player sdk.AccAddress, field uint) []byte {
+	salt := bytes.Repeat([]byte{byte(field)}, BlindSaltLength)
+	round := input.k.getBlindRound(input.ctx, gameID).Round
+	res := input.k.CommitMove(input.ctx, gameID, player, BlindCommitment(player, gameID, round, field, salt))
+	require.True(t, res.IsOK(), res.Log)
+
+	return salt
+}
+
+func TestBlindRounds(t *testing.T) {
+	tests := []struct {
+		name string
+		// Fields of player 1 and player 2 for each round, player 1 commits first
+		rounds [][2]uint
+		fields map[string]uint
+		winner uint
+	}{
+		{"different fields", [][2]uint{{0, 8}}, testFields(1, 0, 0, 0, 0, 0, 0, 0, 2), WinnerNone},
+		{"collision loses both moves", [][2]uint{{4, 4}}, testFields(), WinnerNone},
+		{"middle row", [][2]uint{{0, 3}, {8, 4}, {2, 5}}, testFields(1, 0, 1, 2, 2, 2, 0, 0, 1), 2},
+		{"middle column", [][2]uint{{1, 0}, {4, 2}, {7, 8}}, testFields(2, 1, 2, 0, 1, 0, 0, 1, 2), 1},
+		{"both lines in a round", [][2]uint{{0, 3}, {1, 4}, {2, 5}}, testFields(1, 1, 1, 2, 2, 2, 0, 0, 0), WinnerDraw},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			game, players := createBlindGame(t, input, VariantBlind)
+
+			for _, fields := range tc.rounds {
+				salt1 := commitBlind(t, input, game.Id, players[0], fields[0])
+				salt2 := commitBlind(t, input, game.Id, players[1], fields[1])
+				require.True(t, input.k.RevealMove(input.ctx, game.Id, players[0], fields[0], salt1).IsOK())
+				require.True(t, input.k.RevealMove(input.ctx, game.Id, players[1], fields[1], salt2).IsOK())
+			}
+
+			game = input.k.getGame(input.ctx, game.Id)
+			require.Equal(t, tc.fields, game.Fields)
+			require.Equal(t, tc.winner, game.Winner)
+		})
+	}
+}
+
+func TestBlindFirstCommitCollision(t *testing.T) {
+	input := createTestInput(t)
+	game, players := createBlindGame(t, input, VariantBlindFirstCommit)
+
+	// Player 2 commits first and gets the field, player 1 picks again in the same round
+	salt2 := commitBlind(t, input, game.Id, players[1], 4)
+	salt1 := commitBlind(t, input, game.Id, players[0], 4)
+	require.True(t, input.k.RevealMove(input.ctx, game.Id, players[0], 4, salt1).IsOK())
+	require.True(t, input.k.RevealMove(input.ctx, game.Id, players[1], 4, salt2).IsOK())
+
+	require.Equal(t, testFields(0, 0, 0, 0, 2, 0, 0, 0, 0), input.k.getGame(input.ctx, game.Id).Fields)
+	require.Equal(t, uint(1), input.k.getBlindRound(input.ctx, game.Id).Round)
+
+	salt1 = commitBlind(t, input, game.Id, players[0], 0)
+	require.True(t, input.k.RevealMove(input.ctx, game.Id, players[0], 0, salt1).IsOK())
+
+	require.Equal(t, testFields(1, 0, 0, 0, 2, 0, 0, 0, 0), input.k.getGame(input.ctx, game.Id).Fields)
+	require.Equal(t, uint(2), input.k.getBlindRound(input.ctx, game.Id).Round)
+}
+
+func TestBlindCommitTimeout(t *testing.T) {
+	input := createTestInput(t)
+	game, players := createBlindGame(t, input, VariantBlind)
+
+	// Without a move timeout the commits get the reveal timeout
+	game = input.k.getGame(input.ctx, game.Id)
+	require.Equal(t, input.ctx.BlockHeight()+DefaultParams().RevealTimeout, game.Deadline)
+
+	commitBlind(t, input, game.Id, players[0], 4)
+
+	input.k.processTimeouts(input.ctx.WithBlockHeight(game.Deadline - 1))
+	require.Equal(t, WinnerNone, input.k.getGame(input.ctx, game.Id).Winner)
+
+	input.k.processTimeouts(input.ctx.WithBlockHeight(game.Deadline))
+	require.Equal(t, uint(1), input.k.getGame(input.ctx, game.Id).Winner)
+}
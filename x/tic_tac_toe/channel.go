@@ -0,0 +1,297 @@
+package tic_tac_toe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Channel games are funded on-chain, the moves are exchanged as states signed by both players.
+// Closing with a finished state pays out right away, any other close starts a challenge in which
+// the player to move has to answer with a later state or a forced move before the period ends.
+type Channel struct {
+	// Nonce of the latest state known on chain, its board is the board of the game
+	Nonce uint64 `json:"nonce"`
+	// A unilateral close is waiting for an answer until the game deadline
+	Challenged bool `json:"challenged"`
+}
+
+// ChannelState is a board both players signed, the nonce is the number of moves played on it. The
+// fields are listed row by row, a map could not be encoded in a transaction.
+type ChannelState struct {
+	GameId uint   `json:"game_id"`
+	Nonce  uint64 `json:"nonce"`
+	Fields []uint `json:"fields"`
+}
+
+// NewChannelState reads a board of nine digits like 120000000, 0 for an empty field
+func NewChannelState(gameID uint, board string) (ChannelState, error) {
+	if len(board) != 9 {
+		return ChannelState{}, fmt.Errorf("Board has to be 9 fields, is %d", len(board))
+	}
+
+	state := ChannelState{GameId: gameID, Fields: make([]uint, len(board))}
+	for i, c := range board {
+		if c < '0' || c > '2' {
+			return ChannelState{}, fmt.Errorf("Field %d has to be 0, 1 or 2", i)
+		}
+
+		state.Fields[i] = uint(c - '0')
+	}
+
+	state.Nonce = uint64(totalMoves(state.fields()))
+
+	return state, nil
+}
+
+// The board keyed by field number as games store it
+func (state ChannelState) fields() map[string]uint {
+	fields := emptyFields()
+	for i, player := range state.Fields {
+		fields[strconv.Itoa(i)] = player
+	}
+
+	return fields
+}
+
+// The chain id is signed as well so a state can not be replayed on another chain
+func ChannelStateSignBytes(chainID string, state ChannelState) []byte {
+	b, err := json.Marshal(struct {
+		ChainId string       `json:"chain_id"`
+		State   ChannelState `json:"state"`
+	}{chainID, state})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(b)
+}
+
+// Player 1 always moves first in a channel, so a board is valid if the move counts fit and at most one player has a line
+func (state ChannelState) validate() sdk.Error {
+	if len(state.Fields) != len(emptyFields()) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Board has to be %d fields, is %d", len(emptyFields()), len(state.Fields)))
+	}
+
+	var moves1, moves2 int
+	for field, player := range state.Fields {
+		switch player {
+		case 0:
+		case 1:
+			moves1++
+		case 2:
+			moves2++
+		default:
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Field %d has to be 0, 1 or 2", field))
+		}
+	}
+
+	if moves1 != moves2 && moves1 != moves2+1 {
+		return sdk.ErrUnknownRequest("Players have to move in turns starting with player 1")
+	}
+
+	if state.Nonce != uint64(moves1+moves2) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Nonce has to be the number of moves, %d", moves1+moves2))
+	}
+
+	fields := state.fields()
+	if hasLine(fields, 1) && hasLine(fields, 2) {
+		return sdk.ErrUnknownRequest("Only one player can have a line")
+	}
+
+	return nil
+}
+
+// A player who never sent a tx has no public key on chain, the msg can carry it instead
+func (k Keeper) verifyChannelSignature(ctx sdk.Context, player sdk.AccAddress, pubKey crypto.PubKey, state ChannelState,
+	signature []byte) sdk.Error {
+	if pubKey == nil {
+		acc := k.accountKeeper.GetAccount(ctx, player)
+		if acc == nil || acc.GetPubKey() == nil {
+			return sdk.ErrInvalidPubKey(fmt.Sprintf("%s has no public key on chain yet, send it along", player))
+		}
+		pubKey = acc.GetPubKey()
+	}
+
+	if !bytes.Equal(pubKey.Address(), player) {
+		return sdk.ErrInvalidPubKey(fmt.Sprintf("Public key does not belong to %s", player))
+	}
+
+	if !pubKey.VerifyBytes(ChannelStateSignBytes(ctx.ChainID(), state), signature) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("Invalid signature of %s", player))
+	}
+
+	return nil
+}
+
+// OpenChannel escrows the stakes of both players, who both sign the msg so fast play takes only the open and the close
+func (k Keeper) OpenChannel(ctx sdk.Context, player1, player2 sdk.AccAddress, amount1, amount2 sdk.Coins) (*Game, sdk.Result) {
+	game, res := k.StartGame(ctx, player1, player2, VariantClassic, amount1, amount2, 0)
+	if game == nil {
+		return nil, res
+	}
+
+	game.Channel = &Channel{}
+	k.storeGame(ctx, game)
+
+	return game, sdk.Result{}
+}
+
+func (k Keeper) getChannelGame(ctx sdk.Context, gameID uint, player sdk.AccAddress) (*Game, sdk.Error) {
+	game := k.getGame(ctx, gameID)
+	if game == nil {
+		return nil, sdk.ErrUnknownRequest("No such game")
+	}
+
+	if game.Channel == nil {
+		return nil, sdk.ErrUnknownRequest("Not a channel game")
+	}
+
+	if !game.Player1.Equals(player) && !game.Player2.Equals(player) {
+		return nil, sdk.ErrUnauthorized("Not playing in this game")
+	}
+
+	if game.Winner != WinnerNone {
+		return nil, sdk.ErrUnknownRequest("Game already finished")
+	}
+
+	return game, nil
+}
+
+// CloseChannel settles a finished state right away, any other state starts a challenge
+func (k Keeper) CloseChannel(ctx sdk.Context, gameID uint, sender sdk.AccAddress, state ChannelState,
+	signature1, signature2 []byte, pubKey1, pubKey2 crypto.PubKey) sdk.Result {
+	game, err := k.getChannelGame(ctx, gameID, sender)
+	if err != nil {
+		return err.Result()
+	}
+
+	if game.Channel.Challenged {
+		return sdk.ErrUnknownRequest("Channel is already being closed, answer the challenge instead").Result()
+	}
+
+	if state.Nonce < game.Channel.Nonce {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("State has to have at least nonce %d", game.Channel.Nonce)).Result()
+	}
+
+	if err := k.checkChannelState(ctx, game, state, signature1, signature2, pubKey1, pubKey2); err != nil {
+		return err.Result()
+	}
+
+	resTags := gameTags(game).AppendTag(TagChannelNonce, strconv.FormatUint(state.Nonce, 10))
+	resTags = resTags.AppendTags(k.applyChannelState(ctx, game, state))
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: resTags}
+}
+
+// ChallengeChannel answers a unilateral close with a later state signed by both players
+func (k Keeper) ChallengeChannel(ctx sdk.Context, gameID uint, sender sdk.AccAddress, state ChannelState,
+	signature1, signature2 []byte, pubKey1, pubKey2 crypto.PubKey) sdk.Result {
+	game, err := k.getChannelGame(ctx, gameID, sender)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !game.Channel.Challenged {
+		return sdk.ErrUnknownRequest("Channel is not being closed").Result()
+	}
+
+	if state.Nonce <= game.Channel.Nonce {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("State has to have a nonce above %d", game.Channel.Nonce)).Result()
+	}
+
+	if err := k.checkChannelState(ctx, game, state, signature1, signature2, pubKey1, pubKey2); err != nil {
+		return err.Result()
+	}
+
+	resTags := gameTags(game).AppendTag(TagChannelNonce, strconv.FormatUint(state.Nonce, 10))
+	resTags = resTags.AppendTags(k.applyChannelState(ctx, game, state))
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: resTags}
+}
+
+// ForceMove plays the next move on chain during a challenge, after it the other player has to answer
+func (k Keeper) ForceMove(ctx sdk.Context, gameID uint, player sdk.AccAddress, field uint) sdk.Result {
+	game, err := k.getChannelGame(ctx, gameID, player)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !game.Channel.Challenged {
+		return sdk.ErrUnknownRequest("Moves can only be forced while the channel is being closed").Result()
+	}
+
+	if !game.Player(game.PlayerToMove()).Equals(player) {
+		return sdk.ErrUnknownRequest("Not your turn").Result()
+	}
+
+	fieldStr := strconv.Itoa(int(field))
+	if isFieldTaken(game.Fields, fieldStr) {
+		return sdk.ErrUnknownRequest("Field is already taken").Result()
+	}
+
+	state := ChannelState{GameId: game.Id, Nonce: game.Channel.Nonce + 1, Fields: make([]uint, len(game.Fields))}
+	for i := range state.Fields {
+		state.Fields[i] = game.Fields[strconv.Itoa(i)]
+	}
+	state.Fields[field] = game.PlayerToMove()
+
+	resTags := gameTags(game).AppendTag(TagChannelNonce, strconv.FormatUint(state.Nonce, 10))
+	resTags = resTags.AppendTags(k.applyChannelState(ctx, game, state))
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: resTags}
+}
+
+// The initial board needs no signatures, the players agreed on it by opening the channel
+func (k Keeper) checkChannelState(ctx sdk.Context, game *Game, state ChannelState, signature1, signature2 []byte,
+	pubKey1, pubKey2 crypto.PubKey) sdk.Error {
+	if state.GameId != game.Id {
+		return sdk.ErrUnknownRequest("State is for another game")
+	}
+
+	if err := state.validate(); err != nil {
+		return err
+	}
+
+	if state.Nonce == 0 {
+		return nil
+	}
+
+	if err := k.verifyChannelSignature(ctx, game.Player1, pubKey1, state, signature1); err != nil {
+		return err
+	}
+
+	return k.verifyChannelSignature(ctx, game.Player2, pubKey2, state, signature2)
+}
+
+// Puts the state on chain and either settles the game or (re)starts the challenge period
+func (k Keeper) applyChannelState(ctx sdk.Context, game *Game, state ChannelState) sdk.Tags {
+	game.Fields = state.fields()
+	game.Channel.Nonce = state.Nonce
+
+	switch {
+	case hasLine(game.Fields, 1):
+		game.Winner = 1
+	case hasLine(game.Fields, 2):
+		game.Winner = 2
+	case totalMoves(game.Fields) == len(game.Fields):
+		game.Winner = WinnerDraw
+	}
+
+	if game.Winner != WinnerNone {
+		game.Channel.Challenged = false
+		return k.finishGame(ctx, game)
+	}
+
+	game.Channel.Challenged = true
+	k.resetDeadline(ctx, game)
+
+	return sdk.NewTags(TagChannelChallenge, strconv.FormatInt(game.Deadline, 10))
+}
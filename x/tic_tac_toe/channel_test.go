@@ -0,0 +1,187 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+type channelPlayer struct {
+	key  crypto.PrivKey
+	addr sdk.AccAddress
+}
+
+// A player with a key of its own holding 100abc, the account has no public key on chain yet
+func (input testInput) channelPlayer(t *testing.T, name string) channelPlayer {
+	key := secp256k1.GenPrivKeySecp256k1([]byte(name))
+	addr := sdk.AccAddress(key.PubKey().Address())
+
+	acc := input.ak.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(mustParseCoins(t, "100abc")))
+	input.ak.SetAccount(input.ctx, acc)
+
+	return channelPlayer{key: key, addr: addr}
+}
+
+func (p channelPlayer) sign(t *testing.T, state ChannelState) []byte {
+	signature, err := p.key.Sign(ChannelStateSignBytes("test-chain", state))
+	require.NoError(t, err)
+
+	return signature
+}
+
+func openTestChannel(t *testing.T, input testInput, alice, bob channelPlayer) *Game {
+	stake := mustParseCoins(t, "10abc")
+	game, res := input.k.OpenChannel(input.ctx, alice.addr, bob.addr, stake, stake)
+	require.True(t, res.IsOK(), res.Log)
+
+	return game
+}
+
+func TestOpenChannel(t *testing.T) {
+	input := createTestInput(t)
+	alice, bob := input.channelPlayer(t, "alice"), input.channelPlayer(t, "bob")
+
+	msg := NewMsgOpenChannel(alice.addr, bob.addr, mustParseCoins(t, "10abc"), mustParseCoins(t, "20abc"))
+	require.Equal(t, []sdk.AccAddress{alice.addr, bob.addr}, msg.GetSigners())
+
+	// Both stakes are escrowed by the open, the initial board closes it without signatures
+	game, res := input.k.OpenChannel(input.ctx, alice.addr, bob.addr, msg.InviterAmount, msg.OpponentAmount)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, mustParseCoins(t, "90abc"), input.balance(alice.addr))
+	require.Equal(t, mustParseCoins(t, "80abc"), input.balance(bob.addr))
+
+	state, err := NewChannelState(game.Id, "000000000")
+	require.NoError(t, err)
+	require.True(t, input.k.CloseChannel(input.ctx, game.Id, alice.addr, state, nil, nil, nil, nil).IsOK())
+
+	_, res = input.k.OpenChannel(input.ctx, alice.addr, bob.addr, mustParseCoins(t, "10abc"), mustParseCoins(t, "200abc"))
+	require.False(t, res.IsOK())
+}
+
+func TestChannelSignatures(t *testing.T) {
+	tests := []struct {
+		name string
+		// Public keys sent along in the msg, by name of the player they belong to
+		pubKey1 string
+		pubKey2 string
+		// Players whose public key is on chain
+		onChain []string
+		ok      bool
+	}{
+		{"keys in the msg", "alice", "bob", nil, true},
+		{"keys on chain", "", "", []string{"alice", "bob"}, true},
+		{"one key on chain, one in the msg", "", "bob", []string{"alice"}, true},
+		{"no key", "alice", "", nil, false},
+		{"key of the other player", "alice", "alice", nil, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			players := map[string]channelPlayer{"alice": input.channelPlayer(t, "alice"), "bob": input.channelPlayer(t, "bob")}
+			alice, bob := players["alice"], players["bob"]
+			game := openTestChannel(t, input, alice, bob)
+
+			for _, name := range tc.onChain {
+				acc := input.ak.GetAccount(input.ctx, players[name].addr)
+				require.NoError(t, acc.SetPubKey(players[name].key.PubKey()))
+				input.ak.SetAccount(input.ctx, acc)
+			}
+
+			pubKey := func(name string) crypto.PubKey {
+				if name == "" {
+					return nil
+				}
+				return players[name].key.PubKey()
+			}
+
+			state, err := NewChannelState(game.Id, "111220000")
+			require.NoError(t, err)
+			res := input.k.CloseChannel(input.ctx, game.Id, bob.addr, state, alice.sign(t, state), bob.sign(t, state),
+				pubKey(tc.pubKey1), pubKey(tc.pubKey2))
+			require.Equal(t, tc.ok, res.IsOK(), res.Log)
+
+			if tc.ok {
+				require.Equal(t, uint(1), input.k.getGame(input.ctx, game.Id).Winner)
+				require.Equal(t, mustParseCoins(t, "110abc"), input.balance(alice.addr))
+			} else {
+				require.Equal(t, WinnerNone, input.k.getGame(input.ctx, game.Id).Winner)
+			}
+		})
+	}
+}
+
+func TestChannelChallenge(t *testing.T) {
+	input := createTestInput(t)
+	alice, bob := input.channelPlayer(t, "alice"), input.channelPlayer(t, "bob")
+	game := openTestChannel(t, input, alice, bob)
+
+	signed := func(board string) (ChannelState, []byte, []byte) {
+		state, err := NewChannelState(game.Id, board)
+		require.NoError(t, err)
+		return state, alice.sign(t, state), bob.sign(t, state)
+	}
+
+	// Alice closes with an old state, bob answers with the later one
+	state, signature1, signature2 := signed("100000000")
+	res := input.k.CloseChannel(input.ctx, game.Id, alice.addr, state, signature1, signature2, alice.key.PubKey(),
+		bob.key.PubKey())
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.getGame(input.ctx, game.Id).Channel.Challenged)
+
+	state, signature1, signature2 = signed("100020000")
+	res = input.k.ChallengeChannel(input.ctx, game.Id, bob.addr, state, signature1, signature2, alice.key.PubKey(),
+		bob.key.PubKey())
+	require.True(t, res.IsOK(), res.Log)
+
+	state, signature1, signature2 = signed("100000000")
+	require.False(t, input.k.ChallengeChannel(input.ctx, game.Id, alice.addr, state, signature1, signature2,
+		alice.key.PubKey(), bob.key.PubKey()).IsOK())
+
+	// Only the player to move can force a move, then the other player has to answer in time
+	require.False(t, input.k.ForceMove(input.ctx, game.Id, bob.addr, 8).IsOK())
+	require.False(t, input.k.ForceMove(input.ctx, game.Id, alice.addr, 4).IsOK())
+	require.True(t, input.k.ForceMove(input.ctx, game.Id, alice.addr, 8).IsOK())
+
+	game = input.k.getGame(input.ctx, game.Id)
+	require.Equal(t, uint64(3), game.Channel.Nonce)
+	require.Equal(t, testFields(1, 0, 0, 0, 2, 0, 0, 0, 1), game.Fields)
+
+	input.k.processTimeouts(input.ctx.WithBlockHeight(game.Deadline - 1))
+	require.Equal(t, WinnerNone, input.k.getGame(input.ctx, game.Id).Winner)
+
+	input.k.processTimeouts(input.ctx.WithBlockHeight(game.Deadline))
+	require.Equal(t, uint(1), input.k.getGame(input.ctx, game.Id).Winner)
+	require.Equal(t, mustParseCoins(t, "110abc"), input.balance(alice.addr))
+	require.Equal(t, mustParseCoins(t, "90abc"), input.balance(bob.addr))
+}
+
+func TestChannelMsgsEncode(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	key := secp256k1.GenPrivKeySecp256k1([]byte("alice"))
+	state, err := NewChannelState(1, "120000000")
+	require.NoError(t, err)
+
+	msgs := []sdk.Msg{
+		NewMsgCloseChannel(1, testAddress("alice"), state, []byte{1}, []byte{2}, key.PubKey(), nil),
+		NewMsgChallengeChannel(1, testAddress("bob"), state, []byte{1}, []byte{2}, nil, key.PubKey()),
+	}
+
+	for _, msg := range msgs {
+		bz, err := cdc.MarshalBinaryLengthPrefixed(msg)
+		require.NoError(t, err)
+
+		var decoded sdk.Msg
+		require.NoError(t, cdc.UnmarshalBinaryLengthPrefixed(bz, &decoded))
+		require.Equal(t, msg, decoded)
+	}
+}
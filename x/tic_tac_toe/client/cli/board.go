@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"tic_tac_toe/x/tic_tac_toe"
+)
+
+// The first player plays X, player 1 until a toss is decided
+func markSymbol(game *tic_tac_toe.Game, player uint) string {
+	first := game.FirstPlayer
+	if first == 0 {
+		first = 1
+	}
+
+	switch player {
+	case 0:
+		return "."
+	case first:
+		return "X"
+	default:
+		return "O"
+	}
+}
+
+// Draws a square board from the text of its cells, row by row, with row and column numbers around it.
+// A field is numbered size * row + column. The highlighted field is shown in reverse video, -1 for none.
+func renderCells(cells []string, highlight int) string {
+	size := int(math.Sqrt(float64(len(cells))))
+
+	width := 1
+	for _, cell := range cells {
+		if len(cell) > width {
+			width = len(cell)
+		}
+	}
+
+	var b strings.Builder
+	border := "   +" + strings.Repeat(strings.Repeat("-", width+2)+"+", size) + "\n"
+
+	b.WriteString("    ")
+	for col := 0; col < size; col++ {
+		b.WriteString(fmt.Sprintf(" %-*d ", width, col))
+		b.WriteString(" ")
+	}
+	b.WriteString("\n")
+
+	b.WriteString(border)
+	for row := 0; row < size; row++ {
+		b.WriteString(fmt.Sprintf("%2d |", row*size))
+		for col := 0; col < size; col++ {
+			cell := fmt.Sprintf(" %-*s ", width, cells[row*size+col])
+			if row*size+col == highlight {
+				cell = "\x1b[7m" + cell + "\x1b[0m"
+			}
+			b.WriteString(cell + "|")
+		}
+		b.WriteString("\n")
+		b.WriteString(border)
+	}
+
+	return b.String()
+}
+
+// Text of every field of a game, empty fields show their number
+func gameCells(game *tic_tac_toe.Game) []string {
+	if game.Quantum != nil {
+		return quantumCells(game)
+	}
+
+	return markCells(game, gameMarks(game))
+}
+
+// Marks by player number
+func markCells(game *tic_tac_toe.Game, marks []uint) []string {
+	cells := make([]string, len(marks))
+	for field, player := range marks {
+		if player == 0 {
+			cells[field] = strconv.Itoa(field)
+		} else {
+			cells[field] = markSymbol(game, player)
+		}
+	}
+
+	return cells
+}
+
+func gameMarks(game *tic_tac_toe.Game) []uint {
+	marks := make([]uint, len(game.Fields))
+	for field, player := range game.Fields {
+		i, err := strconv.Atoi(field)
+		if err == nil && i < len(marks) {
+			marks[i] = player
+		}
+	}
+
+	return marks
+}
+
+// Spooky marks are shown in lower case with the number of the move which placed them
+func quantumCells(game *tic_tac_toe.Game) []string {
+	cells := make([]string, len(game.Fields))
+	for field, player := range gameMarks(game) {
+		if player != 0 {
+			cells[field] = markSymbol(game, player)
+		}
+	}
+
+	for i, mark := range game.Quantum.Marks {
+		if mark.Collapsed != -1 {
+			continue
+		}
+
+		mover := game.FirstPlayer
+		if i%2 == 1 {
+			mover = 3 - game.FirstPlayer
+		}
+
+		for _, field := range mark.Fields {
+			cells[field] += strings.ToLower(markSymbol(game, mover)) + strconv.Itoa(i+1)
+		}
+	}
+
+	for field, cell := range cells {
+		if cell == "" {
+			cells[field] = strconv.Itoa(field)
+		}
+	}
+
+	return cells
+}
+
+// Renders everything a player needs to know about a game
+func renderGame(game *tic_tac_toe.Game) string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("Game %d, %s\n", game.Id, game.Variant))
+	for _, player := range []uint{1, 2} {
+		stake := game.Amount1
+		if player == 2 {
+			stake = game.Amount2
+		}
+
+		line := fmt.Sprintf("%s  %s", markSymbol(game, player), game.Player(player))
+		if player == 2 && game.HouseLevel != 0 {
+			line += fmt.Sprintf(" (house, level %d)", game.HouseLevel)
+		}
+		if !stake.IsZero() {
+			line += fmt.Sprintf(" stakes %s", stake)
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n")
+
+	b.WriteString(renderCells(gameCells(game), -1))
+	b.WriteString("\n")
+
+	switch {
+	case game.Winner == tic_tac_toe.WinnerDraw:
+		b.WriteString("Result: draw\n")
+	case game.Winner == tic_tac_toe.WinnerCancelled:
+		b.WriteString("Result: cancelled, the stakes went back\n")
+	case game.Winner != tic_tac_toe.WinnerNone:
+		b.WriteString(fmt.Sprintf("Result: %s wins, %s\n", markSymbol(game, game.Winner), game.Player(game.Winner)))
+	case game.AwaitingAccept:
+		b.WriteString(fmt.Sprintf("Turn: waiting for %s to accept\n", game.Player2))
+	case game.PlayerToMove() == 0:
+		b.WriteString("Turn: waiting for the toss\n")
+	default:
+		b.WriteString(fmt.Sprintf("Turn: %s, %s\n", markSymbol(game, game.PlayerToMove()), game.Player(game.PlayerToMove())))
+	}
+
+	if game.Quantum != nil && game.Quantum.PendingCollapse != 0 {
+		b.WriteString(fmt.Sprintf("Move %d closed a cycle and has to be collapsed\n", game.Quantum.PendingCollapse))
+	}
+
+	if game.Winner == tic_tac_toe.WinnerNone {
+		if game.Deadline != 0 {
+			b.WriteString(fmt.Sprintf("Deadline: height %d, %d blocks per move\n", game.Deadline, game.MoveTimeout))
+		} else {
+			b.WriteString("Deadline: none\n")
+		}
+	}
+
+	if game.Channel != nil {
+		b.WriteString(fmt.Sprintf("Channel: nonce %d", game.Channel.Nonce))
+		if game.Channel.Challenged {
+			b.WriteString(", being closed")
+		}
+		b.WriteString("\n")
+	}
+
+	var partOf []string
+	if game.TournamentId != 0 {
+		partOf = append(partOf, fmt.Sprintf("tournament %d", game.TournamentId))
+	}
+	if game.LeagueId != 0 {
+		partOf = append(partOf, fmt.Sprintf("league %d", game.LeagueId))
+	}
+	if game.MatchId != 0 {
+		partOf = append(partOf, fmt.Sprintf("match %d", game.MatchId))
+	}
+	if len(partOf) > 0 {
+		b.WriteString(fmt.Sprintf("Part of %s\n", strings.Join(partOf, ", ")))
+	}
+
+	return b.String()
+}
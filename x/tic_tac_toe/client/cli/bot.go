@@ -0,0 +1,363 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clientContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"tic_tac_toe/engine"
+	"tic_tac_toe/solver"
+	"tic_tac_toe/x/tic_tac_toe"
+)
+
+const (
+	flagStrategy      = "strategy"
+	flagAcceptInvites = "accept-invites"
+	flagMinStake      = "min-stake"
+	flagMaxStake      = "max-stake"
+	flagRetries       = "retries"
+	flagEngine        = "engine"
+	flagMoveTime      = "movetime"
+)
+
+// Strategy picks the field the bot plays at a height, it is only asked while it is the bot's turn
+type Strategy func(game *tic_tac_toe.Game, height int64) (uint, error)
+
+func newStrategy(name string) (Strategy, error) {
+	switch name {
+	case "perfect":
+		return perfectStrategy, nil
+	case "random":
+		return randomStrategy, nil
+	case "engine":
+		return engineStrategy(viper.GetString(flagEngine), viper.GetInt64(flagMoveTime))
+	default:
+		return nil, fmt.Errorf("Unknown strategy %s, use perfect, random or engine", name)
+	}
+}
+
+func perfectStrategy(game *tic_tac_toe.Game, height int64) (uint, error) {
+	move, err := solver.Best(solver.FromFields(game.Fields, game.PlayerToMove()))
+	if err != nil {
+		// The chain does not count every line, so the game can go on after the solver thinks it is over
+		return randomStrategy(game, height)
+	}
+
+	return uint(move.Field), nil
+}
+
+func randomStrategy(game *tic_tac_toe.Game, height int64) (uint, error) {
+	var free []uint
+	for i := 0; i < 9; i++ {
+		if game.Fields[strconv.Itoa(i)] == 0 {
+			free = append(free, uint(i))
+		}
+	}
+
+	if len(free) == 0 {
+		return 0, fmt.Errorf("No free field")
+	}
+
+	return free[rand.Intn(len(free))], nil
+}
+
+// Asks an external engine process, see the engine package for the protocol
+func engineStrategy(command string, moveTime int64) (Strategy, error) {
+	e, err := engine.Start(command)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Info = func(text string) {
+		fmt.Printf("%s: %s\n", e.Name, text)
+	}
+
+	if err := e.Ready(); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Engine %s by %s\n", e.Name, e.Author)
+
+	return func(game *tic_tac_toe.Game, height int64) (uint, error) {
+		clock := engine.Clock{MoveTimeout: game.MoveTimeout, MoveTime: moveTime}
+		if game.Deadline != 0 {
+			clock.BlocksLeft = game.Deadline - height
+		}
+
+		field, err := e.Move(game.Variant, solver.FromFields(game.Fields, game.PlayerToMove()), clock)
+		if err != nil {
+			return 0, err
+		}
+
+		return uint(field), nil
+	}, nil
+}
+
+// Invites are games somebody else started with the bot as opponent, the bot only accepts those whose
+// stake for the bot is within the range and declines the others. The denoms of max stake are the ones
+// the bot plays for.
+type invitePolicy struct {
+	accept   bool
+	minStake sdk.Coins
+	maxStake sdk.Coins
+}
+
+func (policy invitePolicy) accepts(stake sdk.Coins) bool {
+	if !policy.accept {
+		return false
+	}
+
+	if !stake.IsAllGTE(policy.minStake) {
+		return false
+	}
+
+	return policy.maxStake.Empty() || policy.maxStake.IsAllGTE(stake)
+}
+
+type bot struct {
+	*signer
+	queryRoute string
+	strategy   Strategy
+	policy     invitePolicy
+	retries    int
+}
+
+func GetCmdBot(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	botCmd := &cobra.Command{
+		Use:   "bot",
+		Short: "Bot subcommands",
+	}
+
+	runCmd := &cobra.Command{
+		Use:   "run",
+		Short: "plays the games of the --from key whenever it is its turn, following the moves through node events",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := clientContext.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			strategy, err := newStrategy(viper.GetString(flagStrategy))
+			if err != nil {
+				return err
+			}
+
+			policy := invitePolicy{accept: viper.GetBool(flagAcceptInvites)}
+			if policy.minStake, err = sdk.ParseCoins(viper.GetString(flagMinStake)); err != nil {
+				return err
+			}
+			if policy.maxStake, err = sdk.ParseCoins(viper.GetString(flagMaxStake)); err != nil {
+				return err
+			}
+
+			passphrase, err := keys.GetPassphrase(cliCtx.GetFromName())
+			if err != nil {
+				return err
+			}
+
+			b := &bot{
+				signer:     newSigner(cliCtx, cdc, passphrase),
+				queryRoute: queryRoute,
+				strategy:   strategy,
+				policy:     policy,
+				retries:    viper.GetInt(flagRetries),
+			}
+
+			return b.run()
+		},
+	}
+
+	runCmd.Flags().String(flagStrategy, "perfect", "how the bot picks its moves: perfect, random or engine")
+	runCmd.Flags().String(flagEngine, "", "command line of the engine process for the engine strategy")
+	runCmd.Flags().Int64(flagMoveTime, 1000, "milliseconds the engine has for a move")
+	runCmd.Flags().Bool(flagAcceptInvites, false, "accept games other players start against the bot, otherwise they are declined")
+	runCmd.Flags().String(flagMinStake, "", "smallest stake of the bot in an invite it plays, like 10abc")
+	runCmd.Flags().String(flagMaxStake, "", "largest stake of the bot in an invite it plays, its denoms are the only ones accepted")
+	runCmd.Flags().Int(flagRetries, 3, "attempts to resend a move which failed to go through")
+
+	botCmd.AddCommand(client.PostCommands(runCmd)...)
+
+	return botCmd
+}
+
+func (b *bot) run() error {
+	if err := b.syncAccount(); err != nil {
+		return err
+	}
+
+	node, err := b.cliCtx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	if err := node.Start(); err != nil {
+		return err
+	}
+	defer node.Stop()
+
+	query := tmquery.MustParse(fmt.Sprintf("tm.event = 'Tx' AND %s = '%s'", tic_tac_toe.TagNextPlayer, b.address))
+	subscription, err := node.Subscribe(context.Background(), "tttcli-bot", query, 100)
+	if err != nil {
+		return err
+	}
+
+	invites, err := node.Subscribe(context.Background(), "tttcli-bot",
+		tmquery.MustParse(fmt.Sprintf("tm.event = 'Tx' AND %s = '%s'", tic_tac_toe.TagInvitee, b.address)), 100)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Playing for %s\n", b.address)
+
+	status, err := node.Status()
+	if err != nil {
+		return err
+	}
+
+	if err := b.catchUp(status.SyncInfo.LatestBlockHeight); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case msg := <-invites.Out():
+			gameID, err := strconv.Atoi(msg.Tags()[tic_tac_toe.TagGameId])
+			if err != nil {
+				fmt.Printf("Event without game id: %s\n", err)
+				continue
+			}
+
+			if err := b.answer(uint(gameID)); err != nil {
+				fmt.Printf("Game %d: %s\n", gameID, err)
+			}
+		case msg := <-subscription.Out():
+			gameID, err := strconv.Atoi(msg.Tags()[tic_tac_toe.TagGameId])
+			if err != nil {
+				fmt.Printf("Event without game id: %s\n", err)
+				continue
+			}
+
+			height, _ := strconv.ParseInt(msg.Tags()[tmtypes.TxHeightKey], 10, 64)
+
+			if err := b.move(uint(gameID), height); err != nil {
+				fmt.Printf("Game %d: %s\n", gameID, err)
+			}
+		case <-subscription.Cancelled():
+			return subscription.Err()
+		case <-invites.Cancelled():
+			return invites.Err()
+		}
+	}
+}
+
+// Games which waited for the bot while it was not running send no new event, so they are looked up once
+// after subscribing. Moves and invites which came in since then are skipped by move and answer.
+func (b *bot) catchUp(height int64) error {
+	games, err := queryGames(b.cliCtx, b.queryRoute, b.address)
+	if err != nil {
+		return err
+	}
+
+	for _, game := range games {
+		if game.Winner != tic_tac_toe.WinnerNone {
+			continue
+		}
+
+		if game.AwaitingAccept {
+			err = b.answer(game.Id)
+		} else {
+			err = b.move(game.Id, height)
+		}
+
+		if err != nil {
+			fmt.Printf("Game %d: %s\n", game.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// Plays in the game if it is still the bot's turn, a failed move is retried with a fresh sequence
+// as long as the game still waits for it
+func (b *bot) move(gameID uint, height int64) error {
+	for attempt := 0; ; attempt++ {
+		game, err := queryGame(b.cliCtx, b.queryRoute, strconv.Itoa(int(gameID)))
+		if err != nil {
+			return err
+		}
+
+		if game.Winner != tic_tac_toe.WinnerNone || game.PlayerToMove() == 0 || !game.Player(game.PlayerToMove()).Equals(b.address) {
+			return nil
+		}
+
+		if !b.plays(game) {
+			return nil
+		}
+
+		field, err := b.strategy(game, height)
+		if err != nil {
+			return err
+		}
+
+		err = b.send(tic_tac_toe.NewMsgPlay(gameID, b.address, field))
+		if err == nil {
+			fmt.Printf("Game %d: played %d\n", gameID, field)
+			return nil
+		}
+
+		if attempt >= b.retries {
+			return err
+		}
+
+		fmt.Printf("Game %d: move failed, retrying: %s\n", gameID, err)
+		time.Sleep(time.Second)
+
+		if err := b.syncAccount(); err != nil {
+			return err
+		}
+	}
+}
+
+// The bot only plays classic games on chain
+func (b *bot) plays(game *tic_tac_toe.Game) bool {
+	return game.Variant == tic_tac_toe.VariantClassic && game.Channel == nil
+}
+
+// Accepts an invite which fits the policy and declines any other, the stake of a match is the one of
+// the whole series. The bot takes no part in tosses so it declines games starting with one.
+func (b *bot) answer(gameID uint) error {
+	game, err := queryGame(b.cliCtx, b.queryRoute, strconv.Itoa(int(gameID)))
+	if err != nil {
+		return err
+	}
+
+	if game.Winner != tic_tac_toe.WinnerNone || !game.AwaitingAccept || !game.Player2.Equals(b.address) {
+		return nil
+	}
+
+	stake := game.Amount2
+	if game.MatchId != 0 {
+		match, err := queryMatch(b.cliCtx, b.queryRoute, game.MatchId)
+		if err != nil {
+			return err
+		}
+		stake = match.Amount2
+	}
+
+	if b.plays(game) && game.Toss == nil && b.policy.accepts(stake) {
+		fmt.Printf("Game %d: accepting invite from %s staking %s\n", game.Id, game.Player1, stake)
+		return b.send(tic_tac_toe.NewMsgAcceptGame(game.Id, b.address))
+	}
+
+	fmt.Printf("Game %d: declining invite from %s staking %s\n", game.Id, game.Player1, stake)
+	return b.send(tic_tac_toe.NewMsgCancelGame(game.Id, b.address))
+}
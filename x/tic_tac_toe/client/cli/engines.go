@@ -0,0 +1,362 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"tic_tac_toe/engine"
+	"tic_tac_toe/solver"
+	"tic_tac_toe/x/tic_tac_toe"
+)
+
+const flagGames = "games"
+
+// GetCmdEngines holds the commands for engines running outside the chain
+func GetCmdEngines() *cobra.Command {
+	enginesCmd := &cobra.Command{
+		Use:   "engines",
+		Short: "Engine subcommands",
+	}
+
+	enginesCmd.AddCommand(
+		GetCmdCheckEngine(),
+		GetCmdAddEngine(),
+		GetCmdRemoveEngine(),
+		GetCmdListEngines(),
+		GetCmdEngineMatch(),
+	)
+
+	return enginesCmd
+}
+
+func GetCmdCheckEngine() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check [engine_command]",
+		Short: "runs the conformance checks of the engine protocol against an engine, like check \"tttengine\"",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var failed int
+			for _, result := range engine.Conformance(args[0]) {
+				if result.Err != nil {
+					failed++
+					fmt.Printf("FAIL %s: %s\n", result.Name, result.Err)
+				} else {
+					fmt.Printf("ok   %s\n", result.Name)
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d checks failed", failed)
+			}
+
+			return nil
+		},
+	}
+}
+
+// Registered engines by name, kept in the home of the CLI
+func enginesFile() string {
+	return filepath.Join(viper.GetString(cli.HomeFlag), "engines.json")
+}
+
+func loadEngines() (map[string]string, error) {
+	engines := make(map[string]string)
+
+	bz, err := ioutil.ReadFile(enginesFile())
+	if os.IsNotExist(err) {
+		return engines, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(bz, &engines); err != nil {
+		return nil, err
+	}
+
+	return engines, nil
+}
+
+func saveEngines(engines map[string]string) error {
+	bz, err := json.MarshalIndent(engines, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(enginesFile()), 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(enginesFile(), bz, 0600)
+}
+
+func GetCmdAddEngine() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add [name] [engine_command]",
+		Short: "registers an engine for matches, adding a name again replaces its command",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			engines, err := loadEngines()
+			if err != nil {
+				return err
+			}
+
+			engines[args[0]] = args[1]
+
+			return saveEngines(engines)
+		},
+	}
+}
+
+func GetCmdRemoveEngine() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove [name]",
+		Short: "removes a registered engine",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			engines, err := loadEngines()
+			if err != nil {
+				return err
+			}
+
+			if _, ok := engines[args[0]]; !ok {
+				return fmt.Errorf("No engine %s", args[0])
+			}
+
+			delete(engines, args[0])
+
+			return saveEngines(engines)
+		},
+	}
+}
+
+func GetCmdListEngines() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "shows the registered engines",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			engines, err := loadEngines()
+			if err != nil {
+				return err
+			}
+
+			for _, name := range sortedNames(engines) {
+				fmt.Printf("%s: %s\n", name, engines[name])
+			}
+
+			return nil
+		},
+	}
+}
+
+func sortedNames(engines map[string]string) []string {
+	var names []string
+	for name := range engines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Openings the games of a pairing cycle through: an empty board and every first move
+var matchOpenings = [][]uint{{}, {0}, {1}, {2}, {3}, {4}, {5}, {6}, {7}, {8}}
+
+// A player of a match, its engine is restarted after a failure since its answers can not be trusted anymore
+type matchPlayer struct {
+	name    string
+	command string
+	engine  *engine.Engine
+}
+
+func (p *matchPlayer) move(game solver.Game, moveTime int64) (int, error) {
+	if p.engine == nil {
+		e, err := engine.Start(p.command)
+		if err != nil {
+			return 0, err
+		}
+		p.engine = e
+	}
+
+	field, err := p.engine.Move(tic_tac_toe.VariantClassic, game, engine.Clock{MoveTime: moveTime})
+	if err != nil {
+		p.engine.Kill()
+		p.engine = nil
+	}
+
+	return field, err
+}
+
+// Plays one game judged by the solver, whose lines the module uses too, a player who fails to answer
+// with a free field loses. The score is the one of the first player.
+func playMatchGame(first, second *matchPlayer, opening []uint, moveTime int64) (float64, error) {
+	game := solver.NewGame(3, 3)
+	for _, field := range opening {
+		game.Cells[field] = game.ToMove
+		game.ToMove = 3 - game.ToMove
+	}
+
+	players := []*matchPlayer{first, second}
+	for _, player := range players {
+		if player.engine != nil {
+			if err := player.engine.NewGame(); err != nil {
+				player.engine.Kill()
+				player.engine = nil
+			}
+		}
+	}
+
+	for {
+		switch game.Winner() {
+		case 1:
+			return 1, nil
+		case 2:
+			return 0, nil
+		}
+
+		if game.Over() {
+			return 0.5, nil
+		}
+
+		player := players[game.ToMove-1]
+		field, err := player.move(game, moveTime)
+		if err != nil {
+			return float64(game.ToMove - 1), fmt.Errorf("%s forfeits: %s", player.name, err)
+		}
+
+		game.Cells[field] = game.ToMove
+		game.ToMove = 3 - game.ToMove
+	}
+}
+
+func GetCmdEngineMatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "match [name]...",
+		Short: "plays registered engines against each other off-chain and prints a crosstable with Elo estimates, all of them if no names are given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			engines, err := loadEngines()
+			if err != nil {
+				return err
+			}
+
+			names := args
+			if len(names) == 0 {
+				names = sortedNames(engines)
+			}
+
+			if len(names) < 2 {
+				return fmt.Errorf("A match needs at least 2 engines, register them with engines add")
+			}
+
+			var players []*matchPlayer
+			for _, name := range names {
+				command, ok := engines[name]
+				if !ok {
+					return fmt.Errorf("No engine %s", name)
+				}
+				players = append(players, &matchPlayer{name: name, command: command})
+			}
+
+			defer func() {
+				for _, player := range players {
+					if player.engine != nil {
+						player.engine.Close()
+					}
+				}
+			}()
+
+			games := viper.GetInt(flagGames)
+			moveTime := viper.GetInt64(flagMoveTime)
+
+			// Both engines of a pairing play every opening with either colour before the next opening
+			var results []engine.Result
+			for i := range players {
+				for j := i + 1; j < len(players); j++ {
+					for g := 0; g < games; g++ {
+						opening := matchOpenings[(g/2)%len(matchOpenings)]
+
+						first, second := i, j
+						if g%2 == 1 {
+							first, second = j, i
+						}
+
+						score, err := playMatchGame(players[first], players[second], opening, moveTime)
+						if err != nil {
+							fmt.Printf("%s vs %s: %s\n", players[first].name, players[second].name, err)
+						}
+
+						results = append(results, engine.Result{Player1: first, Player2: second, Score: score})
+					}
+				}
+			}
+
+			printCrosstable(names, results)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagGames, 20, "games every pair of engines plays, colours alternate")
+	cmd.Flags().Int64(flagMoveTime, 1000, "milliseconds an engine has for a move")
+
+	return cmd
+}
+
+func printCrosstable(names []string, results []engine.Result) {
+	scores := make([][]float64, len(names))
+	games := make([][]int, len(names))
+	for i := range names {
+		scores[i] = make([]float64, len(names))
+		games[i] = make([]int, len(names))
+	}
+
+	for _, result := range results {
+		scores[result.Player1][result.Player2] += result.Score
+		scores[result.Player2][result.Player1] += 1 - result.Score
+		games[result.Player1][result.Player2]++
+		games[result.Player2][result.Player1]++
+	}
+
+	width := 8
+	for _, name := range names {
+		if len(name)+2 > width {
+			width = len(name) + 2
+		}
+	}
+
+	header := fmt.Sprintf("%-*s", width, "")
+	for _, name := range names {
+		header += fmt.Sprintf("%*s", width, name)
+	}
+	fmt.Println(header + fmt.Sprintf("%10s%8s%20s", "score", "elo", "95%"))
+
+	ratings := engine.Ratings(len(names), results)
+	for i, name := range names {
+		row := fmt.Sprintf("%-*s", width, name)
+		var total float64
+		for j := range names {
+			if i == j {
+				row += fmt.Sprintf("%*s", width, "-")
+				continue
+			}
+
+			row += fmt.Sprintf("%*s", width, fmt.Sprintf("%g/%d", scores[i][j], games[i][j]))
+			total += scores[i][j]
+		}
+
+		rating := ratings[i]
+		interval := fmt.Sprintf("[%.0f, %.0f]", rating.Lower, rating.Upper)
+		fmt.Println(row + fmt.Sprintf("%10s%8.0f%20s", fmt.Sprintf("%g/%d", total, rating.Games), rating.Elo, interval))
+	}
+
+	fmt.Println(strings.Repeat("-", width*(len(names)+1)+38))
+	fmt.Println("Elo is relative to the average of the engines, the interval comes from each engine's own results")
+}
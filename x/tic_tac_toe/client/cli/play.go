@@ -0,0 +1,638 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/chzyer/readline"
+	"github.com/cosmos/cosmos-sdk/client"
+	clientContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"tic_tac_toe/x/tic_tac_toe"
+)
+
+// Keys as the terminal sends them in raw mode
+const (
+	keyUp        = "\x1b[A"
+	keyDown      = "\x1b[B"
+	keyRight     = "\x1b[C"
+	keyLeft      = "\x1b[D"
+	keyEnter     = "\r"
+	keyEscape    = "\x1b"
+	keyBackspace = "\x7f"
+	keyCtrlC     = "\x03"
+)
+
+// Questions asked when starting a game, in order
+var challengeQuestions = []string{
+	"Opponent address, or house",
+	"Your stake, like 10abc, empty for none",
+	"Opponent stake, empty for the same",
+	"Blocks per move, empty for no time limit",
+}
+
+// Full screen view of the games of the --from key. The list shows every game of the player, a game is
+// opened to move a cursor over its board and play. State is only changed by the loop in run.
+type playScreen struct {
+	*signer
+	queryRoute string
+
+	games  []*tic_tac_toe.Game
+	height int64
+	// Index of the selected game in games
+	selected int
+	// Whether the board of the selected game has the cursor
+	open   bool
+	cursor int
+
+	// Answers to the challenge questions while a challenge is entered, nil otherwise
+	answers []string
+	input   string
+
+	// A transaction is on its way, only one is sent at a time so the sequence stays right
+	busy   bool
+	status string
+}
+
+type sendResult struct {
+	action string
+	err    error
+}
+
+func GetCmdPlayScreen(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "play",
+		Short: "opens a full screen view of the games of the --from key to start, accept and play games, following the node's events",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := clientContext.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			passphrase, err := keys.GetPassphrase(cliCtx.GetFromName())
+			if err != nil {
+				return err
+			}
+
+			s := &playScreen{
+				signer:     newSigner(cliCtx, cdc, passphrase),
+				queryRoute: queryRoute,
+				status:     "Loading games",
+			}
+
+			return s.run()
+		},
+	}
+
+	return client.PostCommands(cmd)[0]
+}
+
+func (s *playScreen) run() error {
+	if err := s.syncAccount(); err != nil {
+		return err
+	}
+
+	node, err := s.cliCtx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return err
+	}
+	s.height = status.SyncInfo.LatestBlockHeight
+
+	if err := node.Start(); err != nil {
+		return err
+	}
+	defer node.Stop()
+
+	txs, err := node.Subscribe(context.Background(), "tttcli-play", tmtypes.EventQueryTx, 100)
+	if err != nil {
+		return err
+	}
+
+	blocks, err := node.Subscribe(context.Background(), "tttcli-play", tmtypes.EventQueryNewBlockHeader, 100)
+	if err != nil {
+		return err
+	}
+
+	fd := int(os.Stdin.Fd())
+	state, err := readline.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer readline.Restore(fd, state)
+
+	// Alternate screen without a cursor, given back as it was on the way out
+	fmt.Print("\x1b[?1049h\x1b[?25l")
+	defer fmt.Print("\x1b[?25h\x1b[?1049l")
+
+	keys := make(chan string)
+	go func() {
+		buf := make([]byte, 16)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				close(keys)
+				return
+			}
+			for _, key := range splitKeys(string(buf[:n])) {
+				keys <- key
+			}
+		}
+	}()
+
+	results := make(chan sendResult)
+
+	s.refresh()
+	for {
+		s.draw()
+
+		select {
+		case key, ok := <-keys:
+			if !ok || !s.handleKey(key, results) {
+				return nil
+			}
+		case result := <-results:
+			s.busy = false
+			if result.err != nil {
+				s.status = fmt.Sprintf("%s failed: %s", result.action, result.err)
+				if err := s.syncAccount(); err != nil {
+					s.status += fmt.Sprintf(", account: %s", err)
+				}
+			} else {
+				s.status = result.action + " went through"
+			}
+			s.refresh()
+		case msg := <-txs.Out():
+			if _, ok := msg.Tags()[tic_tac_toe.TagGameId]; ok {
+				s.refresh()
+			}
+		case msg := <-blocks.Out():
+			if header, ok := msg.Data().(tmtypes.EventDataNewBlockHeader); ok {
+				s.height = header.Header.Height
+			}
+			// Games run out of time without a transaction
+			if s.deadlinePassed() {
+				s.refresh()
+			}
+		case <-txs.Cancelled():
+			return txs.Err()
+		case <-blocks.Cancelled():
+			return blocks.Err()
+		}
+	}
+}
+
+// A read can hold several keys when they are typed or pasted fast, arrow keys are escape sequences
+func splitKeys(input string) []string {
+	var keys []string
+	for len(input) > 0 {
+		if strings.HasPrefix(input, "\x1b[") && len(input) >= 3 {
+			keys = append(keys, input[:3])
+			input = input[3:]
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(input)
+		keys = append(keys, input[:size])
+		input = input[size:]
+	}
+
+	return keys
+}
+
+// Loads the games again keeping the selected game selected
+func (s *playScreen) refresh() {
+	games, err := queryGames(s.cliCtx, s.queryRoute, s.address)
+	if err != nil {
+		s.status = fmt.Sprintf("Could not load games: %s", err)
+		return
+	}
+
+	var selectedID uint
+	if game := s.selectedGame(); game != nil {
+		selectedID = game.Id
+	}
+
+	// Games waiting for the player come first, finished games last, newer games before older ones
+	sort.SliceStable(games, func(i, j int) bool {
+		ri, rj := s.rank(games[i]), s.rank(games[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return games[i].Id > games[j].Id
+	})
+
+	s.games = games
+	s.selected = 0
+	for i, game := range games {
+		if game.Id == selectedID {
+			s.selected = i
+		}
+	}
+
+	if s.status == "Loading games" {
+		s.status = ""
+	}
+}
+
+func (s *playScreen) rank(game *tic_tac_toe.Game) int {
+	switch {
+	case game.Winner != tic_tac_toe.WinnerNone:
+		return 3
+	case s.myTurn(game):
+		return 0
+	case s.invited(game):
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (s *playScreen) deadlinePassed() bool {
+	for _, game := range s.games {
+		if game.Winner == tic_tac_toe.WinnerNone && game.Deadline != 0 && game.Deadline <= s.height {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *playScreen) selectedGame() *tic_tac_toe.Game {
+	if s.selected < 0 || s.selected >= len(s.games) {
+		return nil
+	}
+
+	return s.games[s.selected]
+}
+
+// Number of the player in a game, 1 or 2
+func (s *playScreen) seat(game *tic_tac_toe.Game) uint {
+	if game.Player1.Equals(s.address) {
+		return 1
+	}
+
+	return 2
+}
+
+func (s *playScreen) opponent(game *tic_tac_toe.Game) sdk.AccAddress {
+	return game.Player(3 - s.seat(game))
+}
+
+func (s *playScreen) myTurn(game *tic_tac_toe.Game) bool {
+	return game.Winner == tic_tac_toe.WinnerNone && game.PlayerToMove() == s.seat(game)
+}
+
+// Somebody else invited the player to the game, which waits for the player to accept
+func (s *playScreen) invited(game *tic_tac_toe.Game) bool {
+	return game.Winner == tic_tac_toe.WinnerNone && game.AwaitingAccept && s.seat(game) == 2
+}
+
+// Only classic games played move by move on chain can be played here, the other variants need
+// commitments or signed states the tx commands take care of
+func playable(game *tic_tac_toe.Game) bool {
+	return game.Variant == tic_tac_toe.VariantClassic && game.Channel == nil
+}
+
+func (s *playScreen) describe(game *tic_tac_toe.Game) string {
+	switch {
+	case game.Winner == tic_tac_toe.WinnerDraw:
+		return "draw"
+	case game.Winner == tic_tac_toe.WinnerCancelled:
+		return "cancelled"
+	case game.Winner == s.seat(game):
+		return "won"
+	case game.Winner != tic_tac_toe.WinnerNone:
+		return "lost"
+	case s.invited(game):
+		return "invite"
+	case game.AwaitingAccept:
+		return "waiting for accept"
+	case game.PlayerToMove() == 0:
+		return "waiting for the toss"
+	case s.myTurn(game):
+		return "your turn"
+	default:
+		return "their turn"
+	}
+}
+
+// Handles a key press, false ends the session
+func (s *playScreen) handleKey(key string, results chan<- sendResult) bool {
+	if key == keyCtrlC {
+		return false
+	}
+
+	if s.answers != nil {
+		s.handlePromptKey(key, results)
+		return true
+	}
+
+	if s.open {
+		s.handleBoardKey(key, results)
+		return true
+	}
+
+	switch key {
+	case keyUp, "k":
+		if s.selected > 0 {
+			s.selected--
+		}
+	case keyDown, "j":
+		if s.selected < len(s.games)-1 {
+			s.selected++
+		}
+	case keyEnter, " ", "a":
+		if game := s.selectedGame(); game != nil {
+			s.open = true
+			s.cursor = firstFree(game)
+			s.status = ""
+		}
+	case "y":
+		if game := s.selectedGame(); game != nil {
+			s.accept(game, results)
+		}
+	case "x":
+		if game := s.selectedGame(); game != nil {
+			s.cancel(game, results)
+		}
+	case "n":
+		s.answers = []string{}
+		s.input = ""
+		s.status = ""
+	case "r":
+		s.refresh()
+	case "q":
+		return false
+	}
+
+	return true
+}
+
+func (s *playScreen) handleBoardKey(key string, results chan<- sendResult) {
+	game := s.selectedGame()
+	if game == nil {
+		s.open = false
+		return
+	}
+
+	size := int(math.Sqrt(float64(len(game.Fields))))
+	row, col := s.cursor/size, s.cursor%size
+
+	switch key {
+	case keyUp, "k":
+		row = (row + size - 1) % size
+	case keyDown, "j":
+		row = (row + 1) % size
+	case keyLeft, "h":
+		col = (col + size - 1) % size
+	case keyRight, "l":
+		col = (col + 1) % size
+	case keyEnter, " ":
+		s.play(game, results)
+	case keyEscape, keyBackspace, "q":
+		s.open = false
+	}
+
+	s.cursor = row*size + col
+}
+
+func (s *playScreen) play(game *tic_tac_toe.Game, results chan<- sendResult) {
+	switch {
+	case s.busy:
+		s.status = "Wait for the last transaction to go through"
+	case !playable(game):
+		s.status = fmt.Sprintf("Play %s games with the tx commands", describeVariant(game))
+	case !s.myTurn(game):
+		s.status = "It is not your turn"
+	case game.Fields[strconv.Itoa(s.cursor)] != 0:
+		s.status = fmt.Sprintf("Field %d is taken", s.cursor)
+	default:
+		s.send(fmt.Sprintf("Move %d in game %d", s.cursor, game.Id), tic_tac_toe.NewMsgPlay(game.Id, s.address, uint(s.cursor)), results)
+	}
+}
+
+func (s *playScreen) accept(game *tic_tac_toe.Game, results chan<- sendResult) {
+	switch {
+	case s.busy:
+		s.status = "Wait for the last transaction to go through"
+	case !s.invited(game):
+		s.status = fmt.Sprintf("Game %d is no invite to you", game.Id)
+	default:
+		s.send(fmt.Sprintf("Accept of game %d", game.Id), tic_tac_toe.NewMsgAcceptGame(game.Id, s.address), results)
+	}
+}
+
+// Declines an invite to the player or withdraws one the player sent
+func (s *playScreen) cancel(game *tic_tac_toe.Game, results chan<- sendResult) {
+	switch {
+	case s.busy:
+		s.status = "Wait for the last transaction to go through"
+	case game.Winner != tic_tac_toe.WinnerNone || !game.AwaitingAccept:
+		s.status = fmt.Sprintf("Game %d is no open invite", game.Id)
+	default:
+		s.send(fmt.Sprintf("Cancel of game %d", game.Id), tic_tac_toe.NewMsgCancelGame(game.Id, s.address), results)
+	}
+}
+
+func describeVariant(game *tic_tac_toe.Game) string {
+	if game.Channel != nil {
+		return "channel"
+	}
+
+	return game.Variant
+}
+
+func (s *playScreen) handlePromptKey(key string, results chan<- sendResult) {
+	switch key {
+	case keyEscape:
+		s.answers = nil
+	case keyBackspace:
+		if len(s.input) > 0 {
+			s.input = s.input[:len(s.input)-1]
+		}
+	case keyEnter:
+		s.answers = append(s.answers, strings.TrimSpace(s.input))
+		s.input = ""
+		if len(s.answers) == len(challengeQuestions) {
+			s.challenge(s.answers, results)
+			s.answers = nil
+		}
+	default:
+		if readline.IsPrintable([]rune(key)[0]) {
+			s.input += key
+		}
+	}
+}
+
+// Starts a classic game from the answers to the challenge questions
+func (s *playScreen) challenge(answers []string, results chan<- sendResult) {
+	if s.busy {
+		s.status = "Wait for the last transaction to go through"
+		return
+	}
+
+	opponent := tic_tac_toe.HouseAddress
+	var houseLevel uint
+	if answers[0] == "house" {
+		houseLevel = tic_tac_toe.HousePerfect
+	} else {
+		var err error
+		if opponent, err = sdk.AccAddressFromBech32(answers[0]); err != nil {
+			s.status = fmt.Sprintf("Bad opponent: %s", err)
+			return
+		}
+	}
+
+	stake, err := sdk.ParseCoins(answers[1])
+	if err != nil {
+		s.status = fmt.Sprintf("Bad stake: %s", err)
+		return
+	}
+
+	opponentStake := stake
+	if answers[2] != "" {
+		if opponentStake, err = sdk.ParseCoins(answers[2]); err != nil {
+			s.status = fmt.Sprintf("Bad opponent stake: %s", err)
+			return
+		}
+	}
+
+	var moveTimeout int64
+	if answers[3] != "" {
+		if moveTimeout, err = strconv.ParseInt(answers[3], 10, 64); err != nil {
+			s.status = fmt.Sprintf("Bad blocks per move: %s", err)
+			return
+		}
+	}
+
+	msg := tic_tac_toe.NewMsgStartGame(s.address, opponent, tic_tac_toe.VariantClassic, stake, opponentStake, moveTimeout, 1, nil, houseLevel)
+	s.send("Challenge", msg, results)
+}
+
+func (s *playScreen) send(action string, msg sdk.Msg, results chan<- sendResult) {
+	if err := msg.ValidateBasic(); err != nil {
+		s.status = fmt.Sprintf("%s: %s", action, err.Result().Log)
+		return
+	}
+
+	s.busy = true
+	s.status = action + " sent, waiting for a block"
+	go func() {
+		results <- sendResult{action, s.signer.send(msg)}
+	}()
+}
+
+func firstFree(game *tic_tac_toe.Game) int {
+	marks := gameMarks(game)
+	center := len(marks) / 2
+	if marks[center] == 0 {
+		return center
+	}
+
+	for field, player := range marks {
+		if player == 0 {
+			return field
+		}
+	}
+
+	return 0
+}
+
+// Redraws the whole screen, the list of games on top and the selected game below it
+func (s *playScreen) draw() {
+	width, height, err := readline.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		width, height = 80, 24
+	}
+
+	var b strings.Builder
+	b.WriteString("\x1b[H\x1b[2J")
+	b.WriteString(fmt.Sprintf("\x1b[1mtic tac toe\x1b[0m  %s  height %d\n\n", s.address, s.height))
+
+	// The list scrolls so the selected game stays in view, the board below needs about 16 lines
+	rows := height - 22
+	if rows < 3 {
+		rows = 3
+	}
+	first := 0
+	if s.selected >= rows {
+		first = s.selected - rows + 1
+	}
+
+	if len(s.games) == 0 {
+		b.WriteString("  No games yet, press n to challenge somebody\n")
+	}
+	for i := first; i < len(s.games) && i < first+rows; i++ {
+		game := s.games[i]
+		opponent := s.opponent(game).String()
+		if game.HouseLevel != 0 {
+			opponent = fmt.Sprintf("the house, level %d", game.HouseLevel)
+		}
+
+		line := fmt.Sprintf("%4d  %-8s vs %s  %s", game.Id, describeVariant(game), opponent, s.describe(game))
+		if len(line) > width-4 && width > 4 {
+			line = line[:width-4]
+		}
+
+		if i == s.selected {
+			b.WriteString("> \x1b[7m" + line + "\x1b[0m\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+	b.WriteString("\n")
+
+	if game := s.selectedGame(); game != nil {
+		highlight := -1
+		if s.open {
+			highlight = s.cursor
+		}
+
+		b.WriteString(fmt.Sprintf("Game %d: you play %s, %s\n", game.Id, markSymbol(game, s.seat(game)), s.describe(game)))
+		if !game.Amount1.IsZero() || !game.Amount2.IsZero() {
+			b.WriteString(fmt.Sprintf("Stakes: yours %s, theirs %s\n", s.stake(game, s.seat(game)), s.stake(game, 3-s.seat(game))))
+		}
+		if game.Winner == tic_tac_toe.WinnerNone && game.Deadline != 0 {
+			b.WriteString(fmt.Sprintf("Deadline: height %d, %d blocks left\n", game.Deadline, game.Deadline-s.height))
+		}
+		b.WriteString(renderCells(gameCells(game), highlight))
+	}
+	b.WriteString("\n")
+
+	if s.answers != nil {
+		b.WriteString(fmt.Sprintf("%s: %s_\n", challengeQuestions[len(s.answers)], s.input))
+		b.WriteString("enter next, esc cancel\n")
+	} else if s.open {
+		b.WriteString("arrows move, enter play, esc back, ctrl-c quit\n")
+	} else {
+		b.WriteString("arrows select, enter open, y accept, x decline or withdraw, n challenge, r reload, q quit\n")
+	}
+
+	if s.status != "" {
+		b.WriteString(s.status + "\n")
+	}
+
+	// Raw mode leaves output processing on, so a newline still returns the carriage
+	fmt.Print(b.String())
+}
+
+func (s *playScreen) stake(game *tic_tac_toe.Game, player uint) sdk.Coins {
+	if player == 1 {
+		return game.Amount1
+	}
+
+	return game.Amount2
+}
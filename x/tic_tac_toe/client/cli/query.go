@@ -1,23 +1,225 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"strconv"
+	"strings"
+	"tic_tac_toe/solver"
 	"tic_tac_toe/x/tic_tac_toe"
 )
 
 func GetCmdQueryGame(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "game [game_id]",
-		Short: "checks the game state",
+		Short: "shows the board and state of a game, --output json for scripts",
+		Long: "Shows the board and state of a game, --output json for scripts. With --trust-node=false the game is " +
+			"read from the store with a Merkle proof, which the light client checks against the validators.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			gameStr := args[0]
+
+			var res []byte
+			var err error
+			if cliCtx.TrustNode {
+				res, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryGame, gameStr), nil)
+			} else {
+				res, err = queryProvenGame(cliCtx, queryRoute, gameStr)
+			}
+			if err != nil {
+				fmt.Printf("Could not check %s: %s\n", gameStr, err)
+				return nil
+			}
+
+			if cliCtx.OutputFormat == "json" {
+				fmt.Println(string(res))
+				return nil
+			}
+
+			game := new(tic_tac_toe.Game)
+			if err := json.Unmarshal(res, game); err != nil {
+				return err
+			}
+
+			fmt.Print(renderGame(game))
+
+			return nil
+		},
+	}
+}
+
+// Reads a game straight from the store, QueryStore verifies the proof against a header certified by the light
+// client. The game is encoded like the querier does.
+func queryProvenGame(cliCtx context.CLIContext, storeName string, gameStr string) ([]byte, error) {
+	id, err := strconv.Atoi(gameStr)
+	if err != nil || id < 0 {
+		return nil, fmt.Errorf("Bad game id %s", gameStr)
+	}
+
+	res, err := cliCtx.QueryStore(tic_tac_toe.GameKey(uint(id)), storeName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("No such game")
+	}
+
+	game := new(tic_tac_toe.Game)
+	if err := cliCtx.Codec.UnmarshalJSON(res, game); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(game)
+}
+
+func GetCmdQueryGames(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "games [address]",
+		Short: "lists the games a player started or was invited to, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryGames, args[0]), nil)
+			if err != nil {
+				fmt.Printf("Could not check %s: %s\n", args[0], err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "shows the current game parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, tic_tac_toe.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var params tic_tac_toe.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+func GetCmdQueryTournament(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tournament [tournament_id]",
+		Short: "shows the tournament bracket and standings",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			tournamentStr := args[0]
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryTournament, tournamentStr), nil)
+			if err != nil {
+				fmt.Printf("Could not check %s: %s\n", tournamentStr, err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdQueryLeague(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "league [league_id]",
+		Short: "shows the league settings and members",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			leagueStr := args[0]
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryLeague, leagueStr), nil)
+			if err != nil {
+				fmt.Printf("Could not check %s: %s\n", leagueStr, err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdQuerySeason(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "season [league_id] [season]",
+		Short: "shows the schedule and standings of a season, the current one if no season is given",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			path := strings.Join(args, "/")
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QuerySeason, path), nil)
+			if err != nil {
+				fmt.Printf("Could not check %s: %s\n", path, err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdQueryMatch(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "match [match_id]",
+		Short: "shows the score of a match series and the ids of its games",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			matchStr := args[0]
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryMatch, matchStr), nil)
+			if err != nil {
+				fmt.Printf("Could not check %s: %s\n", matchStr, err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdQueryBlindRound(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "blind [game_id]",
+		Short: "shows the commitments and reveals of the current round of a blind game",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			gameStr := args[0]
 
-			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryGame, gameStr), nil)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryBlindRound, gameStr), nil)
 			if err != nil {
 				fmt.Printf("Could not check %s: %s\n", gameStr, err)
 				return nil
@@ -29,3 +231,209 @@ func GetCmdQueryGame(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdQueryPlayGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [game_id]",
+		Short: "shows the keys allowed to play for the players of a game",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			gameStr := args[0]
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryPlayGrants, gameStr), nil)
+			if err != nil {
+				fmt.Printf("Could not check %s: %s\n", gameStr, err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdQueryAllowance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowance [game|tournament] [id]",
+		Short: "shows what is left to pay the move fees of a game or tournament",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, tic_tac_toe.QueryAllowance, args[0], args[1]), nil)
+			if err != nil {
+				fmt.Printf("Could not check %s %s: %s\n", args[0], args[1], err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func queryGame(cliCtx context.CLIContext, queryRoute, gameStr string) (*tic_tac_toe.Game, error) {
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryGame, gameStr), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	game := new(tic_tac_toe.Game)
+	if err := json.Unmarshal(res, game); err != nil {
+		return nil, err
+	}
+
+	return game, nil
+}
+
+func queryMatch(cliCtx context.CLIContext, queryRoute string, id uint) (*tic_tac_toe.Match, error) {
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%d", queryRoute, tic_tac_toe.QueryMatch, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	match := new(tic_tac_toe.Match)
+	if err := json.Unmarshal(res, match); err != nil {
+		return nil, err
+	}
+
+	return match, nil
+}
+
+func queryGames(cliCtx context.CLIContext, queryRoute string, player sdk.AccAddress) ([]*tic_tac_toe.Game, error) {
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, tic_tac_toe.QueryGames, player), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var games []*tic_tac_toe.Game
+	if err := json.Unmarshal(res, &games); err != nil {
+		return nil, err
+	}
+
+	return games, nil
+}
+
+func GetCmdQueryHint(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "hint [game_id]",
+		Short: "suggests moves for the player to move, best first, with the outcome they lead to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			game, err := queryGame(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+
+			if game.Winner != tic_tac_toe.WinnerNone {
+				return fmt.Errorf("Game %d is over", game.Id)
+			}
+
+			if game.Quantum != nil {
+				return fmt.Errorf("Hints are only given for classic boards")
+			}
+
+			if game.PlayerToMove() == 0 {
+				return fmt.Errorf("The toss for the first move is not decided yet")
+			}
+
+			moves, err := solver.Evaluate(solver.FromFields(game.Fields, game.PlayerToMove()))
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Player %d (%s) to move\n", game.PlayerToMove(), game.Player(game.PlayerToMove()))
+			for _, move := range moves {
+				fmt.Printf("field %d: %s\n", move.Field, describeMove(move))
+			}
+
+			return nil
+		},
+	}
+}
+
+func describeMove(move solver.Move) string {
+	switch move.Outcome {
+	case solver.Win:
+		return fmt.Sprintf("win in %d", move.Depth)
+	case solver.Loss:
+		return fmt.Sprintf("loss in %d", move.Depth)
+	case solver.Draw:
+		return "draw"
+	default:
+		return fmt.Sprintf("unknown, score %d", move.Score)
+	}
+}
+
+func GetCmdQueryAnalyze(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "analyze [game_id]",
+		Short: "replays a game and marks every move as best, inaccuracy or blunder, --output json for tooling",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			game, err := queryGame(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+
+			if game.Variant != tic_tac_toe.VariantClassic || game.Channel != nil {
+				return fmt.Errorf("Only classic games played move by move on chain can be analysed")
+			}
+
+			if game.FirstPlayer == 0 {
+				return fmt.Errorf("The toss for the first move is not decided yet")
+			}
+
+			moves := make([]int, len(game.Moves))
+			for i, field := range game.Moves {
+				moves[i] = int(field)
+			}
+
+			annotations, err := solver.Analyze(3, 3, game.FirstPlayer, moves)
+			if err != nil {
+				return err
+			}
+
+			if cliCtx.OutputFormat == "json" {
+				out, err := json.MarshalIndent(annotations, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(out))
+				return nil
+			}
+
+			fmt.Printf("Game %d, player %d moves first\n", game.Id, game.FirstPlayer)
+			for _, annotation := range annotations {
+				fmt.Printf("\n%d. player %d plays %d: %s (%s)", annotation.Ply, annotation.Player, annotation.Field,
+					annotation.Class, describeMove(annotation.Played))
+				if annotation.Class == solver.ClassInaccuracy || annotation.Class == solver.ClassBlunder {
+					var best []string
+					for _, move := range annotation.Best {
+						best = append(best, fmt.Sprint(move.Field))
+					}
+					fmt.Printf(", best was %s (%s)", strings.Join(best, " or "), describeMove(annotation.Best[0]))
+				}
+				fmt.Println()
+
+				for row := 0; row < 3; row++ {
+					var marks []string
+					for _, player := range annotation.Cells[row*3 : row*3+3] {
+						marks = append(marks, markSymbol(game, player))
+					}
+					fmt.Println("  " + strings.Join(marks, " "))
+				}
+			}
+
+			return nil
+		},
+	}
+}
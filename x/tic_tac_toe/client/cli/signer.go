@@ -0,0 +1,64 @@
+package cli
+
+import (
+	clientContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+)
+
+// Signs and broadcasts transactions of the --from key one after another, keeping track of its sequence
+// so a long running command does not have to query it for every transaction
+type signer struct {
+	cliCtx     clientContext.CLIContext
+	txBldr     authtxb.TxBuilder
+	address    sdk.AccAddress
+	passphrase string
+}
+
+func newSigner(cliCtx clientContext.CLIContext, cdc *codec.Codec, passphrase string) *signer {
+	return &signer{
+		cliCtx:     cliCtx,
+		txBldr:     authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc)),
+		address:    cliCtx.GetFromAddress(),
+		passphrase: passphrase,
+	}
+}
+
+// Picks up the account number and the sequence of the next transaction
+func (s *signer) syncAccount() error {
+	accNum, err := s.cliCtx.GetAccountNumber(s.address)
+	if err != nil {
+		return err
+	}
+
+	sequence, err := s.cliCtx.GetAccountSequence(s.address)
+	if err != nil {
+		return err
+	}
+
+	s.txBldr = s.txBldr.WithAccountNumber(accNum).WithSequence(sequence)
+
+	return nil
+}
+
+// Waits until the transaction is in a block, it fails if the transaction does
+func (s *signer) send(msg sdk.Msg) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	txBytes, err := s.txBldr.BuildAndSign(s.cliCtx.GetFromName(), s.passphrase, []sdk.Msg{msg})
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.cliCtx.BroadcastTxAndAwaitCommit(txBytes); err != nil {
+		return err
+	}
+
+	s.txBldr = s.txBldr.WithSequence(s.txBldr.Sequence() + 1)
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package cli
 
 import (
-	"errors"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/utils"
@@ -9,24 +11,53 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govUtils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
 	"strconv"
 	"tic_tac_toe/x/tic_tac_toe"
 )
 
+const (
+	flagVariant     = "variant"
+	flagMoveTimeout = "move-timeout"
+	flagTitle       = "title"
+	flagDescription = "description"
+	flagDeposit     = "deposit"
+
+	flagSwissRounds   = "swiss-rounds"
+	flagRoundInterval = "round-interval"
+	flagSeries        = "series"
+	flagToss          = "toss"
+	flagExpiry        = "expiry"
+	flagMaxMoves      = "max-moves"
+	flagHouseLevel    = "house-level"
+	flagPubKey1       = "pubkey-1"
+	flagPubKey2       = "pubkey-2"
+)
+
 func GetCmdStartGame(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
-		Use:   "start [opponent_address]",
-		Short: "starts a new game",
-		Args:  cobra.ExactArgs(2),
+	cmd := &cobra.Command{
+		Use:   "start [opponent_address|house] [amount] [opponent_amount]",
+		Short: "invites an opponent to a new game which starts once they accept it, amounts can hold several tokens like 10abc,5xyz and the opponent stakes the same unless opponent_amount is given",
+		Args:  cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
 
 			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			opponent, err := sdkTypes.AccAddressFromBech32(args[0])
-			if err != nil {
-				return err
+			var err error
+			opponent := tic_tac_toe.HouseAddress
+			houseLevel := uint(viper.GetInt(flagHouseLevel))
+			if args[0] != "house" {
+				opponent, err = sdkTypes.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+			} else if houseLevel == 0 {
+				houseLevel = tic_tac_toe.HousePerfect
 			}
 
 			coins, err := sdk.ParseCoins(args[1])
@@ -34,13 +65,88 @@ func GetCmdStartGame(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			if len(coins) > 1 {
-				return errors.New("Can only bet in one token")
+			opponentCoins := coins
+			if len(args) > 2 {
+				opponentCoins, err = sdk.ParseCoins(args[2])
+				if err != nil {
+					return err
+				}
 			}
 
 			sender := cliCtx.GetFromAddress()
 
-			msg := tic_tac_toe.NewMsgStartGame(sender, opponent, coins[0])
+			var tossCommitment []byte
+			if viper.GetBool(flagToss) {
+				tossCommitment, err = newTossCommitment(sender)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := tic_tac_toe.NewMsgStartGame(sender, opponent, viper.GetString(flagVariant), coins, opponentCoins,
+				viper.GetInt64(flagMoveTimeout), uint(viper.GetInt(flagSeries)), tossCommitment, houseLevel)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagVariant, tic_tac_toe.VariantClassic, "game variant to play")
+	cmd.Flags().Int64(flagMoveTimeout, 0, "blocks a player has for each move and the opponent to accept, 0 for no time limit")
+	cmd.Flags().Uint(flagSeries, 1, "play a best of N series for the stakes")
+	cmd.Flags().Bool(flagToss, false, "decide who moves first by a commit-reveal toss instead of moving first")
+	cmd.Flags().Uint(flagHouseLevel, 0, "difficulty of the house from 1 (easy) to 3 (perfect) when playing against it, perfect by default and for stakes, which also need a move timeout")
+
+	return cmd
+}
+
+func GetCmdAcceptGame(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept [game_id]",
+		Short: "accepts an invite, your stake is taken and the clock of the game starts",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := tic_tac_toe.NewMsgAcceptGame(uint(gameId), cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCancelGame(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel [game_id]",
+		Short: "withdraws your invite or declines one sent to you, the inviter gets the stake back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := tic_tac_toe.NewMsgCancelGame(uint(gameId), cliCtx.GetFromAddress())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -55,7 +161,7 @@ func GetCmdStartGame(cdc *codec.Codec) *cobra.Command {
 func GetCmdPlay(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "play [game_id] [field_id]",
-		Short: "plays a move in the game",
+		Short: "plays a move in the game, for yourself or for a player who granted your key to play",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
@@ -89,6 +195,1071 @@ func GetCmdPlay(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdCommitToss(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit-toss [game_id]",
+		Short: "commits to a new secret for the toss deciding who moves first, the secret is printed to reveal it later",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			commitment, err := newTossCommitment(sender)
+			if err != nil {
+				return err
+			}
+
+			msg := tic_tac_toe.NewMsgCommitToss(uint(gameId), sender, commitment)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRevealToss(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal-toss [game_id] [secret]",
+		Short: "reveals the hex secret committed to for the toss",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			secret, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgRevealToss(uint(gameId), sender, secret)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+// Creates a random toss secret and prints it, losing it means losing the game once the reveal times out
+func newTossCommitment(player sdkTypes.AccAddress) ([]byte, error) {
+	secret := make([]byte, tic_tac_toe.TossSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Toss secret, keep it to reveal it later: %s\n", hex.EncodeToString(secret))
+
+	return tic_tac_toe.TossCommitment(player, secret), nil
+}
+
+func GetCmdCommitMove(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit-move [game_id] [field_id]",
+		Short: "commits to a field in a blind game, the salt is printed to reveal the move later",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			field, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s/%d", tic_tac_toe.QueryBlindRound, gameId), nil)
+			if err != nil {
+				return err
+			}
+
+			var round tic_tac_toe.BlindRound
+			if err := cdc.UnmarshalJSON(res, &round); err != nil {
+				return err
+			}
+
+			salt := make([]byte, tic_tac_toe.BlindSaltLength)
+			if _, err := rand.Read(salt); err != nil {
+				return err
+			}
+
+			fmt.Printf("Salt, keep it to reveal the move later: %s\n", hex.EncodeToString(salt))
+
+			sender := cliCtx.GetFromAddress()
+
+			commitment := tic_tac_toe.BlindCommitment(sender, uint(gameId), round.Round, uint(field), salt)
+
+			msg := tic_tac_toe.NewMsgCommitMove(uint(gameId), sender, commitment)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRevealMove(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal-move [game_id] [field_id] [salt]",
+		Short: "reveals the field committed to in a blind game",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			field, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+
+			salt, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgRevealMove(uint(gameId), sender, uint(field), salt)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdQuantumMove(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "quantum-move [game_id] [field_id] [field_id]",
+		Short: "puts a spooky mark into two fields of a quantum game, both are the same for the last free field",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			field1, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+
+			field2, err := strconv.Atoi(args[2])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgQuantumMove(uint(gameId), sender, uint(field1), uint(field2))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCollapse(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "collapse [game_id] [field_id]",
+		Short: "picks the field the mark which closed a cycle in a quantum game collapses into",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			field, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgCollapse(uint(gameId), sender, uint(field))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdOpenChannel(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "open-channel [opponent_address] [amount] [opponent_amount]",
+		Short: "prints a tx funding a game played off-chain with signed states, the opponent stakes the same unless opponent_amount is given",
+		Long: `Prints the tx opening a channel. Both players stake in it, so both sign it, you first:
+
+tttcli tx tic_tac_toe open-channel [opponent_address] 10abc --from you > open.json
+tttcli tx sign open.json --from you > signed.json
+tttcli tx sign signed.json --from opponent > both.json
+tttcli tx broadcast both.json`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			opponent, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			opponentCoins := coins
+			if len(args) > 2 {
+				opponentCoins, err = sdk.ParseCoins(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgOpenChannel(sender, opponent, coins, opponentCoins)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdkTypes.Msg{msg}, false)
+		},
+	}
+}
+
+// Signs a channel state locally, nothing is sent to the chain
+func GetCmdSignChannelState(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-channel-state [game_id] [board]",
+		Short: "signs a board of a channel game like 120000000 and prints the signature and public key to send to the other player",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI()
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			state, err := tic_tac_toe.NewChannelState(uint(gameId), args[1])
+			if err != nil {
+				return err
+			}
+
+			kb, err := keys.NewKeyBaseFromHomeFlag()
+			if err != nil {
+				return err
+			}
+
+			fromName := cliCtx.GetFromName()
+
+			passphrase, err := keys.GetPassphrase(fromName)
+			if err != nil {
+				return err
+			}
+
+			signature, pubKey, err := kb.Sign(fromName, passphrase, tic_tac_toe.ChannelStateSignBytes(txBldr.ChainID(), state))
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(hex.EncodeToString(signature))
+			fmt.Println(sdk.MustBech32ifyAccPub(pubKey))
+
+			return nil
+		},
+	}
+}
+
+// Reads the board and the hex signatures of player 1 and 2, the signatures can be left out for the initial board
+func channelStateArgs(args []string) (uint, tic_tac_toe.ChannelState, []byte, []byte, error) {
+	var state tic_tac_toe.ChannelState
+
+	gameId, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, state, nil, nil, err
+	}
+
+	state, err = tic_tac_toe.NewChannelState(uint(gameId), args[1])
+	if err != nil {
+		return 0, state, nil, nil, err
+	}
+
+	var signatures [2][]byte
+	for i, arg := range args[2:] {
+		signatures[i], err = hex.DecodeString(arg)
+		if err != nil {
+			return 0, state, nil, nil, err
+		}
+	}
+
+	return uint(gameId), state, signatures[0], signatures[1], nil
+}
+
+// The public keys are optional, they are only needed for a player who never sent a tx
+func channelPubKeys() (crypto.PubKey, crypto.PubKey, error) {
+	var pubKeys [2]crypto.PubKey
+	for i, flag := range []string{flagPubKey1, flagPubKey2} {
+		if viper.GetString(flag) == "" {
+			continue
+		}
+
+		var err error
+		pubKeys[i], err = sdk.GetAccPubKeyBech32(viper.GetString(flag))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return pubKeys[0], pubKeys[1], nil
+}
+
+func addChannelPubKeyFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(flagPubKey1, "", "public key of player 1 as printed by sign-channel-state")
+	cmd.Flags().String(flagPubKey2, "", "public key of player 2 as printed by sign-channel-state")
+
+	return cmd
+}
+
+func GetCmdCloseChannel(cdc *codec.Codec) *cobra.Command {
+	return addChannelPubKeyFlags(&cobra.Command{
+		Use:   "close-channel [game_id] [board] [signature_1] [signature_2]",
+		Short: "settles a finished board signed by both players, any other board starts a challenge",
+		Args:  cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, state, signature1, signature2, err := channelStateArgs(args)
+			if err != nil {
+				return err
+			}
+
+			pubKey1, pubKey2, err := channelPubKeys()
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgCloseChannel(gameId, sender, state, signature1, signature2, pubKey1, pubKey2)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	})
+}
+
+func GetCmdChallengeChannel(cdc *codec.Codec) *cobra.Command {
+	return addChannelPubKeyFlags(&cobra.Command{
+		Use:   "challenge-channel [game_id] [board] [signature_1] [signature_2]",
+		Short: "answers a unilateral close with a later board signed by both players",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, state, signature1, signature2, err := channelStateArgs(args)
+			if err != nil {
+				return err
+			}
+
+			pubKey1, pubKey2, err := channelPubKeys()
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgChallengeChannel(gameId, sender, state, signature1, signature2, pubKey1, pubKey2)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	})
+}
+
+func GetCmdForceMove(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "force-move [game_id] [field_id]",
+		Short: "plays the next move of a channel game on chain while it is being closed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			field, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgForceMove(uint(gameId), sender, uint(field))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdGrantPlay(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-play [game_id] [grantee_address]",
+		Short: "lets another key play your moves in a game, it can not move any funds",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdkTypes.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgGrantPlay(uint(gameId), sender, grantee, viper.GetInt64(flagExpiry),
+				uint(viper.GetInt(flagMaxMoves)))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(flagExpiry, 0, "last height the grant can be used at, 0 for no expiry")
+	cmd.Flags().Uint(flagMaxMoves, 0, "moves the grantee can play, 0 for no limit")
+
+	return cmd
+}
+
+func GetCmdRevokePlay(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-play [game_id] [grantee_address]",
+		Short: "takes back a grant to play your moves in a game",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			gameId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdkTypes.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgRevokePlay(uint(gameId), sender, grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdFundGasAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fund-gas [game|tournament] [id] [amount]",
+		Short: "pays the move fees of the players in your game or tournament, what is left is refunded at the end",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgFundGasAllowance(sender, args[0], uint(id), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCreateTournament(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-tournament [single_elimination|double_elimination] [entry_fee] [max_entrants] [prize_shares]",
+		Short: "creates a tournament, prize shares are the parts of the pool per place like 0.6,0.3,0.1",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			entryFee, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxEntrants, err := strconv.Atoi(args[2])
+			if err != nil {
+				return err
+			}
+
+			prizeShares, err := tic_tac_toe.ParsePrizeShares(args[3])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgCreateTournament(sender, args[0], viper.GetString(flagVariant), entryFee,
+				prizeShares, uint(maxEntrants), viper.GetInt64(flagMoveTimeout))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagVariant, tic_tac_toe.VariantClassic, "game variant to play")
+	cmd.Flags().Int64(flagMoveTimeout, 100, "blocks a player has for each move")
+
+	return cmd
+}
+
+func GetCmdRegister(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "register [tournament_id]",
+		Short: "registers for a tournament and pays the entry fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			tournamentId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgRegister(uint(tournamentId), sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdStartTournament(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "start-tournament [tournament_id]",
+		Short: "closes registration and starts the first round",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			tournamentId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgStartTournament(uint(tournamentId), sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCancelTournament(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-tournament [tournament_id]",
+		Short: "cancels a tournament which has not started yet and refunds the entry fees",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			tournamentId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgCancelTournament(uint(tournamentId), sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCreateLeague(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-league [swiss|round_robin] [max_players] [prize_shares]",
+		Short: "creates a league, prize shares are the parts of the sponsor pool per place like 0.6,0.3,0.1",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			maxPlayers, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+
+			prizeShares, err := tic_tac_toe.ParsePrizeShares(args[2])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgCreateLeague(sender, args[0], viper.GetString(flagVariant), prizeShares, uint(maxPlayers),
+				uint(viper.GetInt(flagSwissRounds)), viper.GetInt64(flagRoundInterval), viper.GetInt64(flagMoveTimeout))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagVariant, tic_tac_toe.VariantClassic, "game variant to play")
+	cmd.Flags().Int64(flagMoveTimeout, 0, "blocks a player has for each move, 0 for no time limit")
+	cmd.Flags().Uint(flagSwissRounds, 5, "rounds of a swiss season")
+	cmd.Flags().Int64(flagRoundInterval, 0, "blocks between the start of two rounds")
+
+	return cmd
+}
+
+func GetCmdJoinLeague(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "join-league [league_id]",
+		Short: "joins a league, members play from the next season on",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			leagueId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgJoinLeague(uint(leagueId), sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSponsorLeague(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sponsor-league [league_id] [amount]",
+		Short: "adds funds to the prize pool of the current season",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			leagueId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgSponsorLeague(uint(leagueId), sender, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdStartSeason(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "start-season [league_id]",
+		Short: "starts the first round of the next season with all current members",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			leagueId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := tic_tac_toe.NewMsgStartSeason(uint(leagueId), sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdProposeParamChange(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-param-change [key] [json_value]",
+		Short: "submits a governance proposal to change a game parameter",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action := tic_tac_toe.ParamChangeAction{
+				Key:   args[0],
+				Value: args[1],
+			}
+
+			return submitProposal(cdc, action)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func GetCmdProposeVariant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-variant [variant] [enabled]",
+		Short: "submits a governance proposal to enable or disable a game variant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			action := tic_tac_toe.VariantAction{
+				Variant: args[0],
+				Enabled: enabled,
+			}
+
+			return submitProposal(cdc, action)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func GetCmdProposeTreasurySpend(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-treasury-spend [recipient_address] [amount]",
+		Short: "submits a governance proposal to pay out funds from the treasury",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			recipient, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			action := tic_tac_toe.TreasurySpendAction{
+				Recipient: recipient,
+				Amount:    amount,
+			}
+
+			return submitProposal(cdc, action)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func GetCmdProposeGasAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-gas-allowance [game|tournament] [id] [amount]",
+		Short: "submits a governance proposal to pay the move fees of a game or tournament from the treasury",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			action := tic_tac_toe.GasAllowanceAction{
+				Scope:  args[0],
+				Id:     uint(id),
+				Amount: amount,
+			}
+
+			return submitProposal(cdc, action)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTitle, "", "title of the proposal")
+	cmd.Flags().String(flagDescription, "", "description of the proposal")
+	cmd.Flags().String(flagDeposit, "", "initial deposit of the proposal")
+}
+
+func submitProposal(cdc *codec.Codec, action tic_tac_toe.ProposalAction) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+	txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+	deposit, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+	if err != nil {
+		return err
+	}
+
+	sender := cliCtx.GetFromAddress()
+
+	msg := tic_tac_toe.NewMsgSubmitProposal(viper.GetString(flagTitle), viper.GetString(flagDescription), action, sender, deposit)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cliCtx.PrintResponse = true
+
+	return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+}
+
+func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit [proposal_id] [amount]",
+		Short: "deposits tokens on a governance proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := gov.NewMsgDeposit(sender, proposalID, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
+func GetCmdVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote [proposal_id] [yes|no|no_with_veto|abstain]",
+		Short: "votes on a governance proposal with the stake of the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			voteOption, err := gov.VoteOptionFromString(govUtils.NormalizeVoteOption(args[1]))
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := gov.NewMsgVote(sender, proposalID, voteOption)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return SendTx(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+}
+
 func SendTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
 	if err := cliCtx.EnsureAccountExists(); err != nil {
 		txBldr = txBldr.WithAccountNumber(0)
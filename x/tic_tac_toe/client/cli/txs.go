@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"strconv"
+	"tic_tac_toe/x/tic_tac_toe"
+)
+
+const (
+	flagPage  = "page"
+	flagLimit = "limit"
+)
+
+func GetCmdQueryGameTxs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "game-txs [game_id]",
+		Short: "lists the transactions of a game, oldest first, including the tournament or league round which started it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := strconv.Atoi(args[0]); err != nil {
+				return fmt.Errorf("Bad game id %s", args[0])
+			}
+
+			return searchTxs(cdc, tic_tac_toe.TagGameId, args[0])
+		},
+	}
+
+	return withPageFlags(cmd)
+}
+
+func GetCmdQueryPlayerTxs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "player-txs [address]",
+		Short: "lists the transactions of every game of a player, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			player, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			return searchTxs(cdc, tic_tac_toe.TagPlayer, player.String())
+		},
+	}
+
+	return withPageFlags(cmd)
+}
+
+func withPageFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Int(flagPage, 1, "page of the results")
+	cmd.Flags().Int(flagLimit, 30, "transactions per page")
+
+	return cmd
+}
+
+// Searches the tags indexed by the node, so it needs index_all_tags or the tag in index_tags of its config
+func searchTxs(cdc *codec.Codec, tag, value string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	txs, err := clienttx.SearchTxs(cliCtx, cdc, []string{fmt.Sprintf("%s='%s'", tag, value)},
+		viper.GetInt(flagPage), viper.GetInt(flagLimit))
+	if err != nil {
+		return err
+	}
+
+	output, err := cdc.MarshalJSONIndent(txs, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(output))
+
+	return nil
+}
@@ -27,11 +27,39 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(client.GetCommands(
 		cli.GetCmdQueryGame(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryGames(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryGameTxs(mc.cdc),
+		cli.GetCmdQueryPlayerTxs(mc.cdc),
+		cli.GetCmdQueryParams(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryTournament(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryLeague(mc.storeKey, mc.cdc),
+		cli.GetCmdQuerySeason(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryMatch(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryBlindRound(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryPlayGrants(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryAllowance(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryHint(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryAnalyze(mc.storeKey, mc.cdc),
 	)...)
 
 	return queryCmd
 }
 
+// GetBotCmd holds the bot which plays the games of a key on its own
+func (mc ModuleClient) GetBotCmd() *cobra.Command {
+	return cli.GetCmdBot(mc.storeKey, mc.cdc)
+}
+
+// GetPlayCmd opens the full screen view to play the games of a key
+func (mc ModuleClient) GetPlayCmd() *cobra.Command {
+	return cli.GetCmdPlayScreen(mc.storeKey, mc.cdc)
+}
+
+// GetEnginesCmd works with engines locally, it needs no node
+func (mc ModuleClient) GetEnginesCmd() *cobra.Command {
+	return cli.GetCmdEngines()
+}
+
 func (mc ModuleClient) GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:   "tic_tac_toe",
@@ -40,7 +68,37 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(client.PostCommands(
 		cli.GetCmdStartGame(mc.cdc),
+		cli.GetCmdAcceptGame(mc.cdc),
+		cli.GetCmdCancelGame(mc.cdc),
 		cli.GetCmdPlay(mc.cdc),
+		cli.GetCmdCommitToss(mc.cdc),
+		cli.GetCmdRevealToss(mc.cdc),
+		cli.GetCmdCommitMove(mc.cdc),
+		cli.GetCmdRevealMove(mc.cdc),
+		cli.GetCmdQuantumMove(mc.cdc),
+		cli.GetCmdCollapse(mc.cdc),
+		cli.GetCmdOpenChannel(mc.cdc),
+		cli.GetCmdSignChannelState(mc.cdc),
+		cli.GetCmdCloseChannel(mc.cdc),
+		cli.GetCmdChallengeChannel(mc.cdc),
+		cli.GetCmdForceMove(mc.cdc),
+		cli.GetCmdGrantPlay(mc.cdc),
+		cli.GetCmdRevokePlay(mc.cdc),
+		cli.GetCmdFundGasAllowance(mc.cdc),
+		cli.GetCmdCreateTournament(mc.cdc),
+		cli.GetCmdRegister(mc.cdc),
+		cli.GetCmdStartTournament(mc.cdc),
+		cli.GetCmdCancelTournament(mc.cdc),
+		cli.GetCmdCreateLeague(mc.cdc),
+		cli.GetCmdJoinLeague(mc.cdc),
+		cli.GetCmdSponsorLeague(mc.cdc),
+		cli.GetCmdStartSeason(mc.cdc),
+		cli.GetCmdProposeParamChange(mc.cdc),
+		cli.GetCmdProposeVariant(mc.cdc),
+		cli.GetCmdProposeTreasurySpend(mc.cdc),
+		cli.GetCmdProposeGasAllowance(mc.cdc),
+		cli.GetCmdDeposit(mc.cdc),
+		cli.GetCmdVote(mc.cdc),
 	)...)
 
 	return txCmd
@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"tic_tac_toe/x/tic_tac_toe"
+)
+
+// A game with the Merkle proof of its stored value, answered by /tictactoe/game/{gameID}?prove=true.
+// The proof goes from the value up to the app hash in the header of block height+1, an auditor checks
+// that header against the validators, for example with tttcli query game --trust-node=false.
+type gameProof struct {
+	// Null with a proof of absence when there is no such game
+	Game   *tic_tac_toe.Game `json:"game"`
+	Height int64             `json:"height"`
+	// Key path of the proof, the store and then the key
+	KeyPath string        `json:"key_path"`
+	Value   []byte        `json:"value"`
+	Proof   *merkle.Proof `json:"proof"`
+	AppHash cmn.HexBytes  `json:"app_hash,omitempty"`
+	// Whether the LCD checked the proof itself, it only does when it does not trust its node
+	Verified bool `json:"verified"`
+}
+
+func writeGameProof(w http.ResponseWriter, cdc *codec.Codec, cliCtx context.CLIContext, gameID uint) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	key := tic_tac_toe.GameKey(gameID)
+	result, err := node.ABCIQueryWithOptions("/store/tictactoe/key", key,
+		rpcclient.ABCIQueryOptions{Height: cliCtx.Height, Prove: true})
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, resp.Log)
+		return
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte("tictactoe"), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL)
+
+	output := gameProof{
+		Height:  resp.Height,
+		KeyPath: keyPath.String(),
+		Value:   resp.Value,
+		Proof:   resp.Proof,
+	}
+
+	if resp.Value != nil {
+		output.Game = new(tic_tac_toe.Game)
+		if err := cdc.UnmarshalJSON(resp.Value, output.Game); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	if !cliCtx.TrustNode {
+		// The app hash for height H is in header H+1
+		header, err := cliCtx.Verify(resp.Height + 1)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		prt := rootmulti.DefaultProofRuntime()
+		if resp.Value == nil {
+			err = prt.VerifyAbsence(resp.Proof, header.AppHash, keyPath.String())
+		} else {
+			err = prt.VerifyValue(resp.Proof, header.AppHash, keyPath.String(), resp.Value)
+		}
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Proof does not verify: %s", err))
+			return
+		}
+
+		output.AppHash = header.AppHash
+		output.Verified = true
+	}
+
+	rest.PostProcessResponse(w, cdc, output, cliCtx.Indent)
+}
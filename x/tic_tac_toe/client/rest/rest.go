@@ -17,13 +17,50 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 // register REST routes
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/tictactoe/game/{gameID}", QueryGame(cdc, context.GetAccountDecoder(cdc), cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/player/{address}/games", queryPlayerGamesHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/game/{gameID}/txs", queryGameTxsHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/player/{address}/txs", queryPlayerTxsHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/ws", wsHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/params", queryParamsHandler(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/tictactoe/game", startGameHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/accept", acceptGameHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/cancel", cancelGameHandler(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/tictactoe/game/{gameID}/play", playHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/toss/commit", commitTossHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/toss/reveal", revealTossHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/blind", queryBlindRoundHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/game/{gameID}/blind/commit", commitMoveHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/blind/reveal", revealMoveHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/quantum/move", quantumMoveHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/quantum/collapse", collapseHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/channel", openChannelHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/channel/close", closeChannelHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/channel/challenge", challengeChannelHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/channel/force", forceMoveHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/grants", queryPlayGrantsHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/game/{gameID}/grants", grantPlayHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/game/{gameID}/grants/revoke", revokePlayHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/allowance/{scope}/{id}", queryAllowanceHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/allowance/{scope}/{id}", fundGasAllowanceHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/match/{matchID}", queryMatchHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/tournament/{tournamentID}", queryTournamentHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/tournament", createTournamentHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/tournament/{tournamentID}/register", registerHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/tournament/{tournamentID}/start", startTournamentHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/tournament/{tournamentID}/cancel", cancelTournamentHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/league/{leagueID}", queryLeagueHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/league/{leagueID}/season", querySeasonHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/league/{leagueID}/season/{season}", querySeasonHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/league", createLeagueHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/league/{leagueID}/join", joinLeagueHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/league/{leagueID}/sponsor", sponsorLeagueHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/tictactoe/league/{leagueID}/season", startSeasonHandler(cdc, cliCtx)).Methods("POST")
 }
 
 // query accountREST Handler
@@ -40,6 +77,12 @@ func QueryGame(
 			return
 		}
 
+		// ?prove=true answers with the Merkle proof of the stored game
+		if r.URL.Query().Get("prove") == "true" {
+			writeGameProof(w, cdc, cliCtx, uint(gameID))
+			return
+		}
+
 		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/game/%d", gameID), nil)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -67,11 +110,38 @@ func QueryGame(
 }
 
 
+func queryParamsHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s", tic_tac_toe.QueryParams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var params tic_tac_toe.Params
+		if err := cdc.UnmarshalJSON(res, &params); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, params, cliCtx.Indent)
+	}
+}
+
 type startGameRequest struct {
-	BaseReq rest.BaseReq `json:"base_req"`
+	BaseReq  rest.BaseReq   `json:"base_req"`
 	Opponent sdk.AccAddress `json:"opponent"`
 	Inviter  sdk.AccAddress `json:"inviter"`
-	Amount sdk.Coin `json:"amount"`
+	Variant  string         `json:"variant"`
+	// Opponent stakes the same as the inviter if opponent_amount is not set
+	InviterAmount  sdk.Coins  `json:"inviter_amount"`
+	OpponentAmount *sdk.Coins `json:"opponent_amount"`
+	MoveTimeout    int64      `json:"move_timeout"`
+	SeriesLength   uint       `json:"series_length"`
+	// Commitment to a toss secret if a toss decides who moves first, see TossCommitment
+	TossCommitment []byte `json:"toss_commitment"`
+	// Difficulty from 1 to 3 when the opponent is the house, the opponent can be left out then
+	HouseLevel uint `json:"house_level"`
 }
 
 func startGameHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -89,7 +159,22 @@ func startGameHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 		}
 
 		// create the message
-		msg := tic_tac_toe.NewMsgStartGame(req.Inviter, req.Opponent, req.Amount)
+		if req.Variant == "" {
+			req.Variant = tic_tac_toe.VariantClassic
+		}
+
+		// The house has no address a client would know
+		if req.Opponent.Empty() && req.HouseLevel != 0 {
+			req.Opponent = tic_tac_toe.HouseAddress
+		}
+
+		opponentAmount := req.InviterAmount
+		if req.OpponentAmount != nil {
+			opponentAmount = *req.OpponentAmount
+		}
+
+		msg := tic_tac_toe.NewMsgStartGame(req.Inviter, req.Opponent, req.Variant, req.InviterAmount, opponentAmount, req.MoveTimeout,
+			req.SeriesLength, req.TossCommitment, req.HouseLevel)
 		err := msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -101,9 +186,79 @@ func startGameHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 }
 
 
+type acceptGameRequest struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Opponent sdk.AccAddress `json:"opponent"`
+}
+
+func acceptGameHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req acceptGameRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgAcceptGame(uint(gameID), req.Opponent)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type cancelGameRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Player  sdk.AccAddress `json:"player"`
+}
+
+func cancelGameHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req cancelGameRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgCancelGame(uint(gameID), req.Player)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
 type playRequest struct {
 	BaseReq rest.BaseReq `json:"base_req"`
-	GameId uint                `json:game_id"`
+	GameId uint                `json:"game_id"`
 	Player sdk.AccAddress `json:"player"`
 	Field  uint                `json:"field"`
 }
@@ -133,3 +288,1000 @@ func playHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
+
+type commitTossRequest struct {
+	BaseReq    rest.BaseReq   `json:"base_req"`
+	Player     sdk.AccAddress `json:"player"`
+	Commitment []byte         `json:"commitment"`
+}
+
+func commitTossHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req commitTossRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgCommitToss(uint(gameID), req.Player, req.Commitment)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revealTossRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Player  sdk.AccAddress `json:"player"`
+	Secret  []byte         `json:"secret"`
+}
+
+func revealTossHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revealTossRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgRevealToss(uint(gameID), req.Player, req.Secret)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func queryBlindRoundHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s/%d", tic_tac_toe.QueryBlindRound, gameID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		round := new(tic_tac_toe.BlindRound)
+		if err := json.Unmarshal(res, round); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, round, cliCtx.Indent)
+	}
+}
+
+// The commitment is BlindCommitment of the player, game, round, field and a secret salt
+type commitMoveRequest struct {
+	BaseReq    rest.BaseReq   `json:"base_req"`
+	Player     sdk.AccAddress `json:"player"`
+	Commitment []byte         `json:"commitment"`
+}
+
+func commitMoveHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req commitMoveRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgCommitMove(uint(gameID), req.Player, req.Commitment)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revealMoveRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Player  sdk.AccAddress `json:"player"`
+	Field   uint           `json:"field"`
+	Salt    []byte         `json:"salt"`
+}
+
+func revealMoveHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revealMoveRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgRevealMove(uint(gameID), req.Player, req.Field, req.Salt)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type quantumMoveRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Player  sdk.AccAddress `json:"player"`
+	Field1  uint           `json:"field_1"`
+	Field2  uint           `json:"field_2"`
+}
+
+func quantumMoveHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req quantumMoveRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgQuantumMove(uint(gameID), req.Player, req.Field1, req.Field2)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type collapseRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Player  sdk.AccAddress `json:"player"`
+	Field   uint           `json:"field"`
+}
+
+func collapseHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req collapseRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgCollapse(uint(gameID), req.Player, req.Field)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type openChannelRequest struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Opponent sdk.AccAddress `json:"opponent"`
+	Inviter  sdk.AccAddress `json:"inviter"`
+	// Opponent stakes the same as the inviter if opponent_amount is not set
+	InviterAmount  sdk.Coins  `json:"inviter_amount"`
+	OpponentAmount *sdk.Coins `json:"opponent_amount"`
+}
+
+func openChannelHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req openChannelRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		opponentAmount := req.InviterAmount
+		if req.OpponentAmount != nil {
+			opponentAmount = *req.OpponentAmount
+		}
+
+		msg := tic_tac_toe.NewMsgOpenChannel(req.Inviter, req.Opponent, req.InviterAmount, opponentAmount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// The signatures are over ChannelStateSignBytes of the chain id and the state
+type channelStateRequest struct {
+	BaseReq    rest.BaseReq             `json:"base_req"`
+	Sender     sdk.AccAddress           `json:"sender"`
+	State      tic_tac_toe.ChannelState `json:"state"`
+	Signature1 []byte                   `json:"signature_1"`
+	Signature2 []byte                   `json:"signature_2"`
+	// Bech32 public keys, only needed for a player without one on chain
+	PubKey1 string `json:"pub_key_1"`
+	PubKey2 string `json:"pub_key_2"`
+}
+
+func (req channelStateRequest) pubKeys() (crypto.PubKey, crypto.PubKey, error) {
+	var pubKeys [2]crypto.PubKey
+	for i, pubKey := range []string{req.PubKey1, req.PubKey2} {
+		if pubKey == "" {
+			continue
+		}
+
+		var err error
+		pubKeys[i], err = sdk.GetAccPubKeyBech32(pubKey)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return pubKeys[0], pubKeys[1], nil
+}
+
+func closeChannelHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req channelStateRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pubKey1, pubKey2, err := req.pubKeys()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgCloseChannel(uint(gameID), req.Sender, req.State, req.Signature1, req.Signature2, pubKey1,
+			pubKey2)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func challengeChannelHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req channelStateRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pubKey1, pubKey2, err := req.pubKeys()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgChallengeChannel(uint(gameID), req.Sender, req.State, req.Signature1, req.Signature2,
+			pubKey1, pubKey2)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func forceMoveHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req playRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgForceMove(uint(gameID), req.Player, req.Field)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func queryPlayGrantsHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s/%d", tic_tac_toe.QueryPlayGrants, gameID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var grants []tic_tac_toe.PlayGrant
+		if err := json.Unmarshal(res, &grants); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, grants, cliCtx.Indent)
+	}
+}
+
+type grantPlayRequest struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Granter  sdk.AccAddress `json:"granter"`
+	Grantee  sdk.AccAddress `json:"grantee"`
+	Expiry   int64          `json:"expiry"`
+	MaxMoves uint           `json:"max_moves"`
+}
+
+func grantPlayHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req grantPlayRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgGrantPlay(uint(gameID), req.Granter, req.Grantee, req.Expiry, req.MaxMoves)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revokePlayRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+func revokePlayHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revokePlayRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		gameID, err := strconv.Atoi(mux.Vars(r)["gameID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgRevokePlay(uint(gameID), req.Granter, req.Grantee)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func queryAllowanceHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		id, err := strconv.Atoi(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s/%s/%d", tic_tac_toe.QueryAllowance, vars["scope"], id), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		allowance := new(tic_tac_toe.GasAllowance)
+		if err := json.Unmarshal(res, allowance); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, allowance, cliCtx.Indent)
+	}
+}
+
+type fundGasAllowanceRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Sponsor sdk.AccAddress `json:"sponsor"`
+	Amount  sdk.Coins      `json:"amount"`
+}
+
+func fundGasAllowanceHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req fundGasAllowanceRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		vars := mux.Vars(r)
+
+		id, err := strconv.Atoi(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgFundGasAllowance(req.Sponsor, vars["scope"], uint(id), req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func queryPlayerGamesHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		player, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s/%s", tic_tac_toe.QueryGames, player), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var games []tic_tac_toe.Game
+		if err := json.Unmarshal(res, &games); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, games, cliCtx.Indent)
+	}
+}
+
+func queryMatchHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		matchID, err := strconv.Atoi(mux.Vars(r)["matchID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s/%d", tic_tac_toe.QueryMatch, matchID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		match := new(tic_tac_toe.Match)
+		if err := json.Unmarshal(res, match); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, match, cliCtx.Indent)
+	}
+}
+
+func queryTournamentHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		tournamentID, err := strconv.Atoi(vars["tournamentID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s/%d", tic_tac_toe.QueryTournament, tournamentID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		tournament := new(tic_tac_toe.Tournament)
+		if err := json.Unmarshal(res, tournament); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, tournament, cliCtx.Indent)
+	}
+}
+
+type createTournamentRequest struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Organiser   sdk.AccAddress `json:"organiser"`
+	Format      string         `json:"format"`
+	Variant     string         `json:"variant"`
+	EntryFee    sdk.Coins      `json:"entry_fee"`
+	PrizeShares []sdk.Dec      `json:"prize_shares"`
+	MaxEntrants uint           `json:"max_entrants"`
+	MoveTimeout int64          `json:"move_timeout"`
+}
+
+func createTournamentHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createTournamentRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		if req.Variant == "" {
+			req.Variant = tic_tac_toe.VariantClassic
+		}
+
+		msg := tic_tac_toe.NewMsgCreateTournament(req.Organiser, req.Format, req.Variant, req.EntryFee,
+			req.PrizeShares, req.MaxEntrants, req.MoveTimeout)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type registerRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Player  sdk.AccAddress `json:"player"`
+}
+
+func registerHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req registerRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		tournamentID, err := strconv.Atoi(mux.Vars(r)["tournamentID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgRegister(uint(tournamentID), req.Player)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type startTournamentRequest struct {
+	BaseReq   rest.BaseReq   `json:"base_req"`
+	Organiser sdk.AccAddress `json:"organiser"`
+}
+
+func startTournamentHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req startTournamentRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		tournamentID, err := strconv.Atoi(mux.Vars(r)["tournamentID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgStartTournament(uint(tournamentID), req.Organiser)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelTournamentHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req startTournamentRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		tournamentID, err := strconv.Atoi(mux.Vars(r)["tournamentID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgCancelTournament(uint(tournamentID), req.Organiser)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func queryLeagueHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		leagueID, err := strconv.Atoi(mux.Vars(r)["leagueID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s/%d", tic_tac_toe.QueryLeague, leagueID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		league := new(tic_tac_toe.League)
+		if err := json.Unmarshal(res, league); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, league, cliCtx.Indent)
+	}
+}
+
+// Without a season number the current season is returned
+func querySeasonHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		leagueID, err := strconv.Atoi(vars["leagueID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		path := fmt.Sprintf("custom/tictactoe/%s/%d", tic_tac_toe.QuerySeason, leagueID)
+		if seasonStr, ok := vars["season"]; ok {
+			season, err := strconv.Atoi(seasonStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			path = fmt.Sprintf("%s/%d", path, season)
+		}
+
+		res, err := cliCtx.QueryWithData(path, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		season := new(tic_tac_toe.Season)
+		if err := json.Unmarshal(res, season); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, season, cliCtx.Indent)
+	}
+}
+
+type createLeagueRequest struct {
+	BaseReq       rest.BaseReq   `json:"base_req"`
+	Organiser     sdk.AccAddress `json:"organiser"`
+	Format        string         `json:"format"`
+	Variant       string         `json:"variant"`
+	PrizeShares   []sdk.Dec      `json:"prize_shares"`
+	MaxPlayers    uint           `json:"max_players"`
+	SwissRounds   uint           `json:"swiss_rounds"`
+	RoundInterval int64          `json:"round_interval"`
+	MoveTimeout   int64          `json:"move_timeout"`
+}
+
+func createLeagueHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createLeagueRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		if req.Variant == "" {
+			req.Variant = tic_tac_toe.VariantClassic
+		}
+
+		msg := tic_tac_toe.NewMsgCreateLeague(req.Organiser, req.Format, req.Variant, req.PrizeShares, req.MaxPlayers,
+			req.SwissRounds, req.RoundInterval, req.MoveTimeout)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type joinLeagueRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Player  sdk.AccAddress `json:"player"`
+}
+
+func joinLeagueHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req joinLeagueRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		leagueID, err := strconv.Atoi(mux.Vars(r)["leagueID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgJoinLeague(uint(leagueID), req.Player)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type sponsorLeagueRequest struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Sponsor sdk.AccAddress `json:"sponsor"`
+	Amount  sdk.Coins      `json:"amount"`
+}
+
+func sponsorLeagueHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req sponsorLeagueRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		leagueID, err := strconv.Atoi(mux.Vars(r)["leagueID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgSponsorLeague(uint(leagueID), req.Sponsor, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type startSeasonRequest struct {
+	BaseReq   rest.BaseReq   `json:"base_req"`
+	Organiser sdk.AccAddress `json:"organiser"`
+}
+
+func startSeasonHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req startSeasonRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		leagueID, err := strconv.Atoi(mux.Vars(r)["leagueID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := tic_tac_toe.NewMsgStartSeason(uint(leagueID), req.Organiser)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,75 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"tic_tac_toe/x/tic_tac_toe"
+)
+
+// Transactions of a game, oldest first, paged with ?page=&limit=
+func queryGameTxsHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		gameID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["gameID"])
+		if !ok {
+			return
+		}
+
+		searchTxs(w, r, cdc, cliCtx, tic_tac_toe.TagGameId, strconv.FormatUint(gameID, 10))
+	}
+}
+
+// Transactions of every game of a player, oldest first, paged with ?page=&limit=
+func queryPlayerTxsHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		player, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		searchTxs(w, r, cdc, cliCtx, tic_tac_toe.TagPlayer, player.String())
+	}
+}
+
+func searchTxs(w http.ResponseWriter, r *http.Request, cdc *codec.Codec, cliCtx context.CLIContext, tag, value string) {
+	page, ok := pageParam(w, r, "page", 1)
+	if !ok {
+		return
+	}
+
+	limit, ok := pageParam(w, r, "limit", 30)
+	if !ok {
+		return
+	}
+
+	txs, err := clienttx.SearchTxs(cliCtx, cdc, []string{fmt.Sprintf("%s='%s'", tag, value)}, page, limit)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	rest.PostProcessResponse(w, cdc, txs, cliCtx.Indent)
+}
+
+func pageParam(w http.ResponseWriter, r *http.Request, name string, defaultValue int) (int, bool) {
+	text := r.URL.Query().Get(name)
+	if text == "" {
+		return defaultValue, true
+	}
+
+	n, err := strconv.Atoi(text)
+	if err != nil || n <= 0 {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s has to be a positive number", name))
+		return 0, false
+	}
+
+	return n, true
+}
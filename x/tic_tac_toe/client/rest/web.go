@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/gorilla/mux"
+	"github.com/rakyll/statik/fs"
+
+	// Bundled web app, regenerate it with make update_web_app after changing client/rest/webapp
+	_ "tic_tac_toe/x/tic_tac_toe/client/rest/statik"
+)
+
+// RegisterWebApp serves the web app under /app/ and sends / there. The app uses the JSON routes of
+// RegisterRoutes, so those have to be registered as well. It holds the only statik assets of the
+// binary, the LCD's swagger UI is not bundled.
+func RegisterWebApp(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	statikFS, err := fs.New()
+	if err != nil {
+		panic(err)
+	}
+
+	r.HandleFunc("/tictactoe/keys", listKeysHandler(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/tictactoe/sign", signHandler(cdc, cliCtx)).Methods("POST")
+
+	r.PathPrefix("/app/").Handler(http.StripPrefix("/app/", http.FileServer(statikFS)))
+	r.Handle("/", http.RedirectHandler("/app/", http.StatusFound))
+}
+
+type keyOutput struct {
+	Name    string         `json:"name"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// Keys of the keybase of the LCD, which can sign through signHandler
+func listKeysHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		kb, err := keys.NewKeyBaseFromHomeFlag()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer kb.CloseDB()
+
+		infos, err := kb.List()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		output := []keyOutput{}
+		for _, info := range infos {
+			output = append(output, keyOutput{info.GetName(), info.GetAddress()})
+		}
+
+		rest.PostProcessResponse(w, cdc, output, cliCtx.Indent)
+	}
+}
+
+type signRequest struct {
+	// Unsigned transaction as the POST routes of the module return it
+	Tx       auth.StdTx `json:"tx"`
+	Name     string     `json:"name"`
+	Password string     `json:"password"`
+	ChainID  string     `json:"chain_id"`
+}
+
+// Signs with a key of the keybase of the LCD, using the current account number and sequence of the key.
+// The answer can be posted to /txs as it is.
+func signHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req signRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		if req.ChainID == "" {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "chain_id required but not specified")
+			return
+		}
+
+		kb, err := keys.NewKeyBaseFromHomeFlag()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer kb.CloseDB()
+
+		info, err := kb.Get(req.Name)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		account, err := cliCtx.GetAccount(info.GetAddress())
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txBldr := authtxb.NewTxBuilder(nil, account.GetAccountNumber(), account.GetSequence(), 0, 0, false,
+			req.ChainID, "", nil, nil).WithKeybase(kb)
+
+		signed, err := txBldr.SignStdTx(req.Name, req.Password, req.Tx, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, clienttx.BroadcastReq{Tx: signed, Return: "block"}, cliCtx.Indent)
+	}
+}
@@ -0,0 +1,527 @@
+// Web app of the LCD. It only talks to the JSON routes of the same server: the module's POST routes build
+// unsigned transactions, /tictactoe/sign signs them with a key of the LCD's keybase and /txs broadcasts
+// them. Players with keys elsewhere sign with tttcli tx sign and paste the result. Games are refreshed on
+// the events of /tictactoe/ws.
+'use strict';
+
+const state = {
+  chainId: '',
+  keys: [],
+  games: [],
+  game: null,
+  // Transaction waiting to be signed outside the browser
+  pending: null,
+  socket: null,
+};
+
+const $ = (id) => document.getElementById(id);
+
+function show(text, info) {
+  $('message').textContent = text || '';
+  $('message').className = info ? 'info' : '';
+}
+
+async function request(method, path, body) {
+  const response = await fetch(path, {
+    method: method,
+    headers: body ? {'Content-Type': 'application/json'} : {},
+    body: body ? JSON.stringify(body) : undefined,
+  });
+
+  const text = await response.text();
+  if (!response.ok) {
+    let error = text;
+    try {
+      error = JSON.parse(text).error || text;
+    } catch (e) {
+      // Not every route answers errors with JSON
+    }
+    throw new Error(error);
+  }
+
+  return text ? JSON.parse(text) : null;
+}
+
+// Amino writes registered types with their name, the routes taking a transaction want the bare value
+function unwrap(tx) {
+  return tx && tx.type && tx.value ? tx.value : tx;
+}
+
+function parseCoins(text) {
+  const coins = [];
+  for (const part of text.split(',').map((s) => s.trim()).filter((s) => s)) {
+    const match = /^(\d+)\s*([a-z][a-z0-9]{2,15})$/.exec(part);
+    if (!match) {
+      throw new Error('Bad amount ' + part + ', use amounts like 10abc,5xyz');
+    }
+    coins.push({denom: match[2], amount: match[1]});
+  }
+
+  return coins;
+}
+
+function formatCoins(coins) {
+  return (coins || []).map((coin) => coin.amount + coin.denom).join(',') || 'nothing';
+}
+
+// Address the app plays for, the selected key or the address typed in for signing elsewhere
+function myAddress() {
+  const name = $('key').value;
+  if (name) {
+    const key = state.keys.find((k) => k.name === name);
+    return key ? key.address : '';
+  }
+
+  return $('address').value.trim();
+}
+
+// Unsigned transactions are signed by the keybase if a key is selected, otherwise they are shown
+// to be signed outside the browser
+async function send(path, body, action) {
+  const address = myAddress();
+  if (!address) {
+    throw new Error('Pick a key or enter your address first');
+  }
+
+  body.base_req = {from: address, chain_id: state.chainId};
+  const unsigned = unwrap(await request('POST', path, body));
+
+  const name = $('key').value;
+  if (!name) {
+    state.pending = {tx: unsigned, action: action};
+    $('unsigned').value = JSON.stringify({type: 'auth/StdTx', value: unsigned}, null, 2);
+    $('signed').value = '';
+    $('sign-command').textContent = 'tttcli tx sign unsigned.json --from <your key> --chain-id ' + state.chainId;
+    $('external').hidden = false;
+    show('Sign the transaction below to ' + action, true);
+    return;
+  }
+
+  show('Signing and waiting for a block to ' + action, true);
+  const signed = await request('POST', '/tictactoe/sign', {
+    tx: unsigned,
+    name: name,
+    password: $('password').value,
+    chain_id: state.chainId,
+  });
+  await broadcast(signed.tx, action);
+}
+
+async function broadcast(tx, action) {
+  const result = await request('POST', '/txs', {tx: tx, return: 'block'});
+  const failed = (result.logs || []).find((log) => !log.success);
+  if (result.code || failed) {
+    throw new Error(action + ' failed: ' + (failed ? failed.log : result.raw_log));
+  }
+
+  show('Done: ' + action, true);
+  await refresh();
+}
+
+function number(value) {
+  return Number(value || 0);
+}
+
+function seat(game, address) {
+  return game.player_1 === address ? 1 : 2;
+}
+
+// Whose turn it is, 1 or 2, or 0 while the toss is not decided
+function playerToMove(game) {
+  const first = number(game.first_player);
+  if (game.awaiting_accept || first === 0) {
+    return 0;
+  }
+
+  let moves = Object.values(game.fields).filter((player) => number(player) !== 0).length;
+  if (game.quantum) {
+    moves = game.quantum.marks.length;
+  }
+
+  return moves % 2 === 0 ? first : 3 - first;
+}
+
+function symbol(game, player) {
+  const first = number(game.first_player) || 1;
+  if (player === 0) {
+    return '';
+  }
+
+  return player === first ? 'X' : 'O';
+}
+
+function describe(game, address) {
+  const me = seat(game, address);
+  const winner = number(game.winner);
+  if (winner === 3) {
+    return 'draw';
+  } else if (winner === 4) {
+    return 'cancelled';
+  } else if (winner !== 0) {
+    return winner === me ? 'won' : 'lost';
+  } else if (game.awaiting_accept) {
+    return me === 2 ? 'invite' : 'waiting for accept';
+  } else if (playerToMove(game) === 0) {
+    return 'waiting for the toss';
+  }
+
+  return playerToMove(game) === me ? 'your turn' : 'their turn';
+}
+
+function group(game, address) {
+  const text = describe(game, address);
+  if (text === 'your turn' || text === 'invite') {
+    return text === 'your turn' ? 'Your turn' : 'Invites';
+  } else if (text === 'won' || text === 'lost' || text === 'draw' || text === 'cancelled') {
+    return 'Finished';
+  }
+
+  return 'Waiting';
+}
+
+function opponentName(game, address) {
+  if (number(game.house_level) !== 0) {
+    return 'the house, level ' + game.house_level;
+  }
+
+  return seat(game, address) === 1 ? game.player_2 : game.player_1;
+}
+
+function drawLobby() {
+  const address = myAddress();
+  const groups = {'Your turn': [], 'Invites': [], 'Waiting': [], 'Finished': []};
+  for (const game of state.games) {
+    groups[group(game, address)].push(game);
+  }
+
+  const container = $('games');
+  container.textContent = '';
+  if (state.games.length === 0) {
+    container.textContent = address ? 'No games yet' : 'Pick a key or enter your address';
+  }
+
+  for (const [title, games] of Object.entries(groups)) {
+    if (games.length === 0) {
+      continue;
+    }
+
+    const div = document.createElement('div');
+    div.className = 'group';
+    const h3 = document.createElement('h3');
+    h3.textContent = title;
+    div.appendChild(h3);
+
+    for (const game of games.sort((a, b) => number(b.id) - number(a.id))) {
+      const button = document.createElement('button');
+      button.className = 'entry' + (state.game && state.game.id === game.id ? ' selected' : '');
+      button.textContent = '#' + game.id + ' ' + game.variant + ' vs ' + opponentName(game, address) +
+        ', ' + describe(game, address);
+      button.onclick = () => openGame(number(game.id));
+      div.appendChild(button);
+    }
+
+    container.appendChild(div);
+  }
+}
+
+function cellsOf(game) {
+  const size = Math.round(Math.sqrt(Object.keys(game.fields).length));
+  const cells = [];
+  for (let field = 0; field < size * size; field++) {
+    cells.push({field: field, player: number(game.fields[String(field)]), spooky: []});
+  }
+
+  if (game.quantum) {
+    game.quantum.marks.forEach((mark, i) => {
+      if (number(mark.collapsed) !== -1) {
+        return;
+      }
+
+      const first = number(game.first_player);
+      const mover = i % 2 === 0 ? first : 3 - first;
+      for (const field of mark.fields) {
+        cells[number(field)].spooky.push(symbol(game, mover).toLowerCase() + (i + 1));
+      }
+    });
+  }
+
+  return {size: size, cells: cells};
+}
+
+function drawGame() {
+  const game = state.game;
+  $('game').hidden = !game;
+  if (!game) {
+    return;
+  }
+
+  const address = myAddress();
+  const me = seat(game, address);
+  const mine = game.player_1 === address || game.player_2 === address;
+
+  $('game-title').textContent = 'Game ' + game.id + ', ' + game.variant + (game.channel ? ' channel' : '');
+  $('players').textContent = '';
+  for (const player of [1, 2]) {
+    const div = document.createElement('div');
+    const stake = player === 1 ? game.amount_1 : game.amount_2;
+    div.textContent = symbol(game, player) + '  ' + (player === 1 ? game.player_1 : game.player_2) +
+      ' stakes ' + formatCoins(stake) + (mine && player === me ? ' (you)' : '');
+    $('players').appendChild(div);
+  }
+
+  // Only classic games played move by move on chain are played here
+  const playable = mine && game.variant === 'classic' && !game.channel && number(game.winner) === 0 &&
+    playerToMove(game) === me;
+
+  const board = cellsOf(game);
+  const container = $('board');
+  container.textContent = '';
+  container.style.gridTemplateColumns = 'repeat(' + board.size + ', 1fr)';
+  for (const cell of board.cells) {
+    const button = document.createElement('button');
+    button.className = 'cell';
+    if (cell.player !== 0) {
+      button.textContent = symbol(game, cell.player);
+    } else if (cell.spooky.length > 0) {
+      button.textContent = cell.spooky.join(' ');
+      button.classList.add('spooky');
+    } else {
+      button.textContent = cell.field;
+      button.classList.add('free');
+      if (playable) {
+        button.classList.add('playable');
+        button.onclick = () => play(game, cell.field);
+      }
+    }
+    container.appendChild(button);
+  }
+
+  const lines = [];
+  const winner = number(game.winner);
+  if (winner === 3) {
+    lines.push('Result: draw');
+  } else if (winner === 4) {
+    lines.push('Result: cancelled, the stakes went back');
+  } else if (winner !== 0) {
+    lines.push('Result: ' + symbol(game, winner) + ' wins');
+  } else if (game.awaiting_accept) {
+    lines.push(me === 2 ? 'Invite, accepting it takes your stake and starts the clock' : 'Waiting for the opponent to accept');
+  } else if (playerToMove(game) === 0) {
+    lines.push('Waiting for the toss, use tttcli tx tic_tac_toe reveal-toss');
+  } else {
+    lines.push('Turn: ' + symbol(game, playerToMove(game)) + (mine && playerToMove(game) === me ? ', yours' : ''));
+    if (mine && !playable && playerToMove(game) === me) {
+      lines.push('Play ' + (game.channel ? 'channel' : game.variant) + ' games with tttcli');
+    }
+  }
+
+  if (winner === 0 && number(game.deadline) !== 0) {
+    lines.push('Deadline: height ' + game.deadline + ', ' + game.move_timeout + ' blocks per move');
+  }
+
+  $('state').textContent = '';
+  for (const line of lines) {
+    const div = document.createElement('div');
+    div.textContent = line;
+    $('state').appendChild(div);
+  }
+
+  if (mine && winner === 0 && game.awaiting_accept) {
+    const actions = me === 2 ? [['Accept', acceptGame], ['Decline', cancelGame]] : [['Withdraw', cancelGame]];
+    for (const [label, action] of actions) {
+      const button = document.createElement('button');
+      button.textContent = label;
+      button.onclick = () => action(game);
+      $('state').appendChild(button);
+    }
+  }
+}
+
+async function acceptGame(game) {
+  try {
+    await send('/tictactoe/game/' + game.id + '/accept', {opponent: myAddress()}, 'accept game ' + game.id);
+  } catch (e) {
+    show(e.message);
+  }
+}
+
+async function cancelGame(game) {
+  try {
+    await send('/tictactoe/game/' + game.id + '/cancel', {player: myAddress()}, 'cancel game ' + game.id);
+  } catch (e) {
+    show(e.message);
+  }
+}
+
+async function play(game, field) {
+  try {
+    await send('/tictactoe/game/' + game.id + '/play', {
+      game_id: String(game.id),
+      player: myAddress(),
+      field: String(field),
+    }, 'play field ' + field + ' in game ' + game.id);
+  } catch (e) {
+    show(e.message);
+  }
+}
+
+async function openGame(id) {
+  try {
+    state.game = await request('GET', '/tictactoe/game/' + id);
+    show('');
+    listen();
+  } catch (e) {
+    show('Could not open game ' + id + ': ' + e.message);
+  }
+
+  drawLobby();
+  drawGame();
+}
+
+async function refresh() {
+  const address = myAddress();
+  try {
+    state.games = address ? await request('GET', '/tictactoe/player/' + address + '/games') : [];
+    if (state.game) {
+      state.game = await request('GET', '/tictactoe/game/' + state.game.id);
+    }
+  } catch (e) {
+    show(e.message);
+  }
+
+  drawLobby();
+  drawGame();
+}
+
+// Subscribes to the games of the account and the open game, reconnecting whenever either changes
+function listen() {
+  if (state.socket) {
+    state.socket.onclose = null;
+    state.socket.close();
+  }
+
+  const query = new URLSearchParams();
+  if (myAddress()) {
+    query.append('player', myAddress());
+  }
+  if (state.game) {
+    query.append('game', state.game.id);
+  }
+
+  const scheme = location.protocol === 'https:' ? 'wss://' : 'ws://';
+  const socket = new WebSocket(scheme + location.host + '/tictactoe/ws?' + query);
+  socket.onmessage = (message) => {
+    const event = JSON.parse(message.data);
+    if (event.type === 'error') {
+      show(event.error);
+    } else if (event.type !== 'subscribed') {
+      refresh();
+    }
+  };
+  socket.onclose = () => setTimeout(() => {
+    if (state.socket === socket) {
+      listen();
+    }
+  }, 2000);
+  state.socket = socket;
+}
+
+async function challenge(event) {
+  event.preventDefault();
+
+  try {
+    const house = $('house').checked;
+    const body = {
+      inviter: myAddress(),
+      opponent: house ? '' : $('opponent').value.trim(),
+      variant: $('variant').value,
+      inviter_amount: parseCoins($('stake').value),
+      move_timeout: String(number($('move-timeout').value)),
+      series_length: '1',
+      house_level: house ? $('house-level').value : '0',
+    };
+    if ($('opponent-stake').value.trim()) {
+      body.opponent_amount = parseCoins($('opponent-stake').value);
+    }
+    if (!house && !body.opponent) {
+      throw new Error('Enter the address of your opponent');
+    }
+
+    await send('/tictactoe/game', body, 'start a game');
+  } catch (e) {
+    show(e.message);
+  }
+}
+
+async function broadcastPending() {
+  try {
+    const signed = unwrap(JSON.parse($('signed').value));
+    const action = state.pending.action;
+    $('external').hidden = true;
+    state.pending = null;
+    show('Waiting for a block to ' + action, true);
+    await broadcast(signed, action);
+  } catch (e) {
+    show(e.message);
+  }
+}
+
+async function start() {
+  try {
+    const nodeInfo = await request('GET', '/node_info');
+    state.chainId = nodeInfo.network;
+    $('chain').textContent = state.chainId;
+
+    state.keys = await request('GET', '/tictactoe/keys');
+    const params = await request('GET', '/tictactoe/params');
+    for (const variant of params.enabled_variants || ['classic']) {
+      const option = document.createElement('option');
+      option.textContent = variant;
+      $('variant').appendChild(option);
+    }
+  } catch (e) {
+    show(e.message);
+  }
+
+  const none = document.createElement('option');
+  none.value = '';
+  none.textContent = 'sign elsewhere';
+  $('key').appendChild(none);
+  for (const key of state.keys) {
+    const option = document.createElement('option');
+    option.value = key.name;
+    option.textContent = key.name;
+    $('key').appendChild(option);
+  }
+  if (state.keys.length > 0) {
+    $('key').value = state.keys[0].name;
+  }
+
+  const accountChanged = () => {
+    $('address').hidden = $('key').value !== '';
+    $('password').hidden = $('key').value === '';
+    state.game = null;
+    refresh();
+    listen();
+  };
+  $('key').onchange = accountChanged;
+  $('address').onchange = accountChanged;
+  $('account').onsubmit = (event) => event.preventDefault();
+  $('challenge').onsubmit = challenge;
+  $('open').onsubmit = (event) => {
+    event.preventDefault();
+    openGame(number($('open-id').value));
+  };
+  $('broadcast').onclick = broadcastPending;
+  $('cancel').onclick = () => {
+    $('external').hidden = true;
+    state.pending = null;
+    show('');
+  };
+
+  accountChanged();
+  // Deadlines pass without any event
+  setInterval(refresh, 15000);
+}
+
+start();
@@ -0,0 +1,430 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	clientContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/websocket"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"tic_tac_toe/x/tic_tac_toe"
+)
+
+// Types of the events sent over /tictactoe/ws. Every event carries the state of the game after it.
+const (
+	EventGameStarted    = "game_started"
+	EventMovePlayed     = "move_played"
+	EventGameFinished   = "game_finished"
+	EventInviteReceived = "invite_received"
+	// Anything else about the game changed, like a toss being revealed
+	EventGameUpdated = "game_updated"
+	// Answers to requests of the client
+	EventSubscribed = "subscribed"
+	EventError      = "error"
+)
+
+type wsEvent struct {
+	Type   string `json:"type"`
+	GameId uint   `json:"game_id,omitempty"`
+	Height int64  `json:"height,omitempty"`
+	// Hash of the transaction behind the event, empty for games changed at the end of a block like timeouts
+	TxHash string          `json:"tx_hash,omitempty"`
+	Game   json.RawMessage `json:"game,omitempty"`
+	Error  string          `json:"error,omitempty"`
+	// Subscriptions of the client after a subscribe or unsubscribe request
+	Games   []uint   `json:"games,omitempty"`
+	Players []string `json:"players,omitempty"`
+}
+
+// A client subscribes like {"action": "subscribe", "game_id": 4} or {"action": "subscribe", "player": "cosmos1..."}
+// and unsubscribes the same way. Subscriptions can also be given when connecting, like
+// /tictactoe/ws?game=4&player=cosmos1...
+type wsRequest struct {
+	Action string `json:"action"`
+	GameId *uint  `json:"game_id"`
+	Player string `json:"player"`
+}
+
+const (
+	wsWriteTimeout = 10 * time.Second
+	wsPingPeriod   = 30 * time.Second
+	// Events a client can fall behind by before it is dropped
+	wsClientBuffer = 64
+)
+
+type wsClient struct {
+	conn *websocket.Conn
+	send chan wsEvent
+	// Closed once the client is gone, send stays open since the hub may still hold the client
+	done chan struct{}
+
+	mtx     sync.Mutex
+	games   map[uint]bool
+	players map[string]bool
+}
+
+// Relays the events of the node to the clients. The node is subscribed to once the first client connects,
+// every event is turned into the game events of the games it touched.
+type wsHub struct {
+	cliCtx   clientContext.CLIContext
+	upgrader websocket.Upgrader
+
+	mtx      sync.Mutex
+	clients  map[*wsClient]bool
+	relaying bool
+	// What the hub last saw of every running game, to tell a move from another change
+	seen map[uint]gameSnapshot
+}
+
+type gameSnapshot struct {
+	board    string
+	winner   uint
+	awaiting bool
+}
+
+func wsHandler(cdc *codec.Codec, cliCtx clientContext.CLIContext) http.HandlerFunc {
+	hub := &wsHub{
+		cliCtx:  cliCtx,
+		clients: make(map[*wsClient]bool),
+		seen:    make(map[uint]gameSnapshot),
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := hub.relay(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+
+		conn, err := hub.upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// The upgrader already answered the request
+			return
+		}
+
+		client := &wsClient{
+			conn:    conn,
+			send:    make(chan wsEvent, wsClientBuffer),
+			done:    make(chan struct{}),
+			games:   make(map[uint]bool),
+			players: make(map[string]bool),
+		}
+
+		for _, game := range r.URL.Query()["game"] {
+			client.handle(wsRequest{Action: "subscribe", GameId: parseGameID(game)})
+		}
+		for _, player := range r.URL.Query()["player"] {
+			client.handle(wsRequest{Action: "subscribe", Player: player})
+		}
+
+		hub.mtx.Lock()
+		hub.clients[client] = true
+		hub.mtx.Unlock()
+
+		go client.write()
+		client.read()
+
+		hub.mtx.Lock()
+		delete(hub.clients, client)
+		hub.mtx.Unlock()
+		close(client.done)
+		conn.Close()
+	}
+}
+
+func parseGameID(text string) *uint {
+	id, err := strconv.Atoi(text)
+	if err != nil || id < 0 {
+		return nil
+	}
+
+	gameID := uint(id)
+	return &gameID
+}
+
+// Handles requests until the connection is closed
+func (c *wsClient) read() {
+	for {
+		var req wsRequest
+		err := c.conn.ReadJSON(&req)
+		switch err.(type) {
+		case nil:
+		case *json.SyntaxError, *json.UnmarshalTypeError:
+			c.push(wsEvent{Type: EventError, Error: err.Error()})
+			continue
+		default:
+			return
+		}
+
+		c.handle(req)
+	}
+}
+
+func (c *wsClient) handle(req wsRequest) {
+	if req.Player != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Player); err != nil {
+			c.push(wsEvent{Type: EventError, Error: fmt.Sprintf("Bad player address %s", err)})
+			return
+		}
+	}
+
+	if req.GameId == nil && req.Player == "" {
+		c.push(wsEvent{Type: EventError, Error: "Give a game_id or a player"})
+		return
+	}
+
+	c.mtx.Lock()
+	switch req.Action {
+	case "subscribe":
+		if req.GameId != nil {
+			c.games[*req.GameId] = true
+		}
+		if req.Player != "" {
+			c.players[req.Player] = true
+		}
+	case "unsubscribe":
+		if req.GameId != nil {
+			delete(c.games, *req.GameId)
+		}
+		delete(c.players, req.Player)
+	default:
+		c.mtx.Unlock()
+		c.push(wsEvent{Type: EventError, Error: fmt.Sprintf("Unknown action %s, use subscribe or unsubscribe", req.Action)})
+		return
+	}
+
+	event := wsEvent{Type: EventSubscribed}
+	for game := range c.games {
+		event.Games = append(event.Games, game)
+	}
+	for player := range c.players {
+		event.Players = append(event.Players, player)
+	}
+	c.mtx.Unlock()
+
+	c.push(event)
+}
+
+// Queues an event, a client which does not keep up is disconnected instead of holding up the others
+func (c *wsClient) push(event wsEvent) {
+	select {
+	case c.send <- event:
+	default:
+		c.conn.Close()
+	}
+}
+
+func (c *wsClient) write() {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case event := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := c.conn.WriteJSON(event); err != nil {
+				c.conn.Close()
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				c.conn.Close()
+				return
+			}
+		}
+	}
+}
+
+func (c *wsClient) wants(game *tic_tac_toe.Game) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.games[game.Id] || c.players[game.Player1.String()] || c.players[game.Player2.String()]
+}
+
+func (c *wsClient) wantsPlayer(player sdk.AccAddress) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.players[player.String()]
+}
+
+// Subscribes to the node unless it already is, a failed subscription is tried again by the next client
+func (h *wsHub) relay() error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.relaying {
+		return nil
+	}
+
+	node, err := h.cliCtx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	if !node.IsRunning() {
+		if err := node.Start(); err != nil {
+			return err
+		}
+	}
+
+	txs, err := node.Subscribe(context.Background(), "tictactoe-ws", tmtypes.EventQueryTx, 100)
+	if err != nil {
+		return err
+	}
+
+	blocks, err := node.Subscribe(context.Background(), "tictactoe-ws", tmtypes.EventQueryNewBlock, 100)
+	if err != nil {
+		node.UnsubscribeAll(context.Background(), "tictactoe-ws")
+		return err
+	}
+
+	h.relaying = true
+
+	go func() {
+		defer func() {
+			node.UnsubscribeAll(context.Background(), "tictactoe-ws")
+			h.mtx.Lock()
+			h.relaying = false
+			h.mtx.Unlock()
+		}()
+
+		for {
+			select {
+			case msg := <-txs.Out():
+				data, ok := msg.Data().(tmtypes.EventDataTx)
+				if ok && data.Result.IsOK() {
+					h.publish(gameIDs(data.Result.Tags), data.Height, fmt.Sprintf("%X", data.Tx.Hash()))
+				}
+			case msg := <-blocks.Out():
+				// Games finished or started at the end of a block, like timeouts and league rounds
+				if data, ok := msg.Data().(tmtypes.EventDataNewBlock); ok {
+					h.publish(gameIDs(data.ResultEndBlock.Tags), data.Block.Height, "")
+				}
+			case <-txs.Cancelled():
+				return
+			case <-blocks.Cancelled():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Every game id in the tags, in order and without repeats
+func gameIDs(tags []cmn.KVPair) []uint {
+	var ids []uint
+	seen := make(map[uint]bool)
+	for _, tag := range tags {
+		if string(tag.Key) != tic_tac_toe.TagGameId {
+			continue
+		}
+
+		id, err := strconv.Atoi(string(tag.Value))
+		if err != nil || seen[uint(id)] {
+			continue
+		}
+
+		seen[uint(id)] = true
+		ids = append(ids, uint(id))
+	}
+
+	return ids
+}
+
+func (h *wsHub) publish(ids []uint, height int64, txHash string) {
+	for _, id := range ids {
+		res, err := h.cliCtx.QueryWithData(fmt.Sprintf("custom/tictactoe/%s/%d", tic_tac_toe.QueryGame, id), nil)
+		if err != nil {
+			continue
+		}
+
+		game := new(tic_tac_toe.Game)
+		if err := json.Unmarshal(res, game); err != nil {
+			continue
+		}
+
+		h.mtx.Lock()
+		types := h.classify(game, txHash != "")
+		clients := make([]*wsClient, 0, len(h.clients))
+		for client := range h.clients {
+			clients = append(clients, client)
+		}
+		h.mtx.Unlock()
+
+		for _, client := range clients {
+			if !client.wants(game) {
+				continue
+			}
+
+			for _, eventType := range types {
+				if eventType == EventInviteReceived && !client.wantsPlayer(game.Player2) {
+					continue
+				}
+
+				client.push(wsEvent{Type: eventType, GameId: id, Height: height, TxHash: txHash, Game: res})
+			}
+		}
+	}
+}
+
+// Tells what happened to a game from what the hub saw of it before, the caller holds the lock. Games
+// changed at the end of a block are not moved in.
+func (h *wsHub) classify(game *tic_tac_toe.Game, byTx bool) []string {
+	board, _ := json.Marshal(struct {
+		Fields  map[string]uint           `json:"fields"`
+		Quantum *tic_tac_toe.QuantumBoard `json:"quantum"`
+	}{game.Fields, game.Quantum})
+
+	before, known := h.seen[game.Id]
+	var types []string
+
+	var marks int
+	for _, player := range game.Fields {
+		if player != 0 {
+			marks++
+		}
+	}
+	if game.Quantum != nil {
+		marks = len(game.Quantum.Marks)
+	}
+
+	// A game with an invite starts once the opponent accepts it
+	switch {
+	case !known && game.AwaitingAccept && game.Winner == tic_tac_toe.WinnerNone:
+		types = append(types, EventInviteReceived)
+	case !known && marks == 0 && game.Winner == tic_tac_toe.WinnerNone,
+		known && before.awaiting && !game.AwaitingAccept && game.Winner == tic_tac_toe.WinnerNone:
+		types = append(types, EventGameStarted)
+	case known && before.board != string(board), !known && byTx:
+		types = append(types, EventMovePlayed)
+	}
+
+	if game.Winner != tic_tac_toe.WinnerNone && (!known || before.winner == tic_tac_toe.WinnerNone) {
+		types = append(types, EventGameFinished)
+	}
+
+	if len(types) == 0 {
+		types = append(types, EventGameUpdated)
+	}
+
+	if game.Winner != tic_tac_toe.WinnerNone {
+		delete(h.seen, game.Id)
+	} else {
+		h.seen[game.Id] = gameSnapshot{string(board), game.Winner, game.AwaitingAccept}
+	}
+
+	return types
+}
@@ -7,5 +7,31 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgStartGame{}, "tictactoe/StartGame", nil)
 	cdc.RegisterConcrete(MsgPlay{}, "tictactoe/Play", nil)
+	cdc.RegisterConcrete(MsgAcceptGame{}, "tictactoe/AcceptGame", nil)
+	cdc.RegisterConcrete(MsgCancelGame{}, "tictactoe/CancelGame", nil)
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "tictactoe/SubmitProposal", nil)
+	cdc.RegisterConcrete(MsgCommitToss{}, "tictactoe/CommitToss", nil)
+	cdc.RegisterConcrete(MsgRevealToss{}, "tictactoe/RevealToss", nil)
+	cdc.RegisterConcrete(MsgCommitMove{}, "tictactoe/CommitMove", nil)
+	cdc.RegisterConcrete(MsgRevealMove{}, "tictactoe/RevealMove", nil)
+	cdc.RegisterConcrete(MsgQuantumMove{}, "tictactoe/QuantumMove", nil)
+	cdc.RegisterConcrete(MsgCollapse{}, "tictactoe/Collapse", nil)
+	cdc.RegisterConcrete(MsgOpenChannel{}, "tictactoe/OpenChannel", nil)
+	cdc.RegisterConcrete(MsgCloseChannel{}, "tictactoe/CloseChannel", nil)
+	cdc.RegisterConcrete(MsgChallengeChannel{}, "tictactoe/ChallengeChannel", nil)
+	cdc.RegisterConcrete(MsgForceMove{}, "tictactoe/ForceMove", nil)
+	cdc.RegisterConcrete(MsgGrantPlay{}, "tictactoe/GrantPlay", nil)
+	cdc.RegisterConcrete(MsgRevokePlay{}, "tictactoe/RevokePlay", nil)
+	cdc.RegisterConcrete(MsgFundGasAllowance{}, "tictactoe/FundGasAllowance", nil)
+	cdc.RegisterConcrete(MsgCreateTournament{}, "tictactoe/CreateTournament", nil)
+	cdc.RegisterConcrete(MsgRegister{}, "tictactoe/Register", nil)
+	cdc.RegisterConcrete(MsgStartTournament{}, "tictactoe/StartTournament", nil)
+	cdc.RegisterConcrete(MsgCancelTournament{}, "tictactoe/CancelTournament", nil)
+	cdc.RegisterConcrete(MsgCreateLeague{}, "tictactoe/CreateLeague", nil)
+	cdc.RegisterConcrete(MsgJoinLeague{}, "tictactoe/JoinLeague", nil)
+	cdc.RegisterConcrete(MsgSponsorLeague{}, "tictactoe/SponsorLeague", nil)
+	cdc.RegisterConcrete(MsgStartSeason{}, "tictactoe/StartSeason", nil)
 	cdc.RegisterConcrete(Game{}, "tictactoe/Game", nil)
+
+	registerProposalCodec(cdc)
 }
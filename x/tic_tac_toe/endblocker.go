@@ -0,0 +1,14 @@
+package tic_tac_toe
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Has to run after the gov EndBlocker so proposals tallied in this block are applied right away
+func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
+	resTags := keeper.processProposals(ctx)
+	resTags = resTags.AppendTags(keeper.processTimeouts(ctx))
+	resTags = resTags.AppendTags(keeper.processLeagueRounds(ctx))
+
+	return resTags
+}
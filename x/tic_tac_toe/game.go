@@ -4,11 +4,100 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	VariantClassic = "classic"
+)
+
+// Values of Game.Winner besides the player number
+const (
+	WinnerNone uint = 0
+	WinnerDraw uint = 3
+	// The game ended without a result and every stake taken went back
+	WinnerCancelled uint = 4
+)
+
 type Game struct {
 	Id      uint            `json:"id"`
-	Amount sdk.Coin `json:"amount"`
+	Variant string          `json:"variant"`
+	// Stakes of player 1 and player 2
+	Amount1 sdk.Coins       `json:"amount_1"`
+	Amount2 sdk.Coins       `json:"amount_2"`
 	Player1 sdk.AccAddress  `json:"player_1"`
 	Player2 sdk.AccAddress  `json:"player_2"`
 	Fields  map[string]uint `json:"fields"`
+	// Fields in the order they were marked, empty for channel and quantum games
+	Moves   []uint          `json:"moves"`
 	Winner  uint            `json:"winner"`
+
+	// Blocks a player has for a move before losing on time, and the opponent has to accept the invite, 0 means no limit
+	MoveTimeout int64 `json:"move_timeout"`
+	// Height at which the player to move loses
+	Deadline int64 `json:"deadline"`
+	// Tournament the game is part of, 0 if none
+	TournamentId uint `json:"tournament_id"`
+	// League the game is part of, 0 if none
+	LeagueId uint `json:"league_id"`
+	// Match series the game is part of, 0 if none
+	MatchId uint `json:"match_id"`
+
+	// Player who plays X and moves first, 0 until a toss is decided
+	FirstPlayer uint `json:"first_player"`
+	// Commit-reveal deciding the first player, nil if player 1 simply moves first
+	Toss *Toss `json:"toss"`
+	// Spooky and collapsed marks of a quantum game, nil for other variants
+	Quantum *QuantumBoard `json:"quantum"`
+	// Off-chain play of a classic game, nil if every move is a transaction
+	Channel *Channel `json:"channel"`
+	// Difficulty of the house playing as player 2, 0 if two players play each other
+	HouseLevel uint `json:"house_level"`
+	// Set until player 2 accepts the invite, only the stake of player 1 is taken before
+	AwaitingAccept bool `json:"awaiting_accept"`
+}
+
+// Pot is everything the winner gets before the rake
+func (game Game) Pot() sdk.Coins {
+	return game.Amount1.Add(game.Amount2)
+}
+
+// Whose turn it is, 1 or 2, or 0 while the invite is not accepted or the toss is not decided
+func (game Game) PlayerToMove() uint {
+	if game.AwaitingAccept || game.FirstPlayer == 0 {
+		return 0
+	}
+
+	moves := totalMoves(game.Fields)
+	if game.Quantum != nil {
+		moves = len(game.Quantum.Marks)
+	}
+
+	if moves%2 == 0 {
+		return game.FirstPlayer
+	}
+
+	return otherPlayer(game.FirstPlayer)
+}
+
+func otherPlayer(number uint) uint {
+	if number == 1 {
+		return 2
+	}
+
+	return 1
+}
+
+func (game Game) Player(number uint) sdk.AccAddress {
+	if number == 1 {
+		return game.Player1
+	}
+
+	return game.Player2
+}
+
+func isKnownVariant(variant string) bool {
+	switch variant {
+	case VariantClassic, VariantBlind, VariantBlindFirstCommit, VariantQuantum:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,29 @@
+package tic_tac_toe
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisState struct {
+	Params Params `json:"params"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.Validate()
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return GenesisState{
+		Params: keeper.GetParams(ctx),
+	}
+}
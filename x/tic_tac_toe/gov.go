@@ -0,0 +1,318 @@
+package tic_tac_toe
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Account holding the module funds, it can only be spent through governance
+var TreasuryAddress = sdk.AccAddress(crypto.AddressHash([]byte("tictactoe/treasury")))
+
+// ProposalAction is what gets applied once a game proposal passes
+type ProposalAction interface {
+	ValidateBasic() sdk.Error
+	ProposalType() gov.ProposalKind
+	Apply(ctx sdk.Context, k Keeper) sdk.Error
+	String() string
+}
+
+// Changes a single parameter of the tic tac toe subspace, value is JSON encoded
+type ParamChangeAction struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func (a ParamChangeAction) ValidateBasic() sdk.Error {
+	if strings.TrimSpace(a.Key) == "" {
+		return sdk.ErrUnknownRequest("Param key is empty")
+	}
+
+	if strings.TrimSpace(a.Value) == "" {
+		return sdk.ErrUnknownRequest("Param value is empty")
+	}
+
+	return nil
+}
+
+func (a ParamChangeAction) ProposalType() gov.ProposalKind {
+	return gov.ProposalTypeParameterChange
+}
+
+func (a ParamChangeAction) Apply(ctx sdk.Context, k Keeper) sdk.Error {
+	p := k.GetParams(ctx)
+
+	var found bool
+	for _, pair := range p.ParamSetPairs() {
+		if string(pair.Key) != a.Key {
+			continue
+		}
+
+		if err := k.cdc.UnmarshalJSON([]byte(a.Value), pair.Value); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Bad value for %s: %s", a.Key, err))
+		}
+
+		found = true
+	}
+
+	if !found {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("No such param: %s", a.Key))
+	}
+
+	if err := p.Validate(); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+
+	k.SetParams(ctx, p)
+
+	return nil
+}
+
+func (a ParamChangeAction) String() string {
+	return fmt.Sprintf("set %s to %s", a.Key, a.Value)
+}
+
+// Enables or disables a game variant
+type VariantAction struct {
+	Variant string `json:"variant"`
+	Enabled bool   `json:"enabled"`
+}
+
+func (a VariantAction) ValidateBasic() sdk.Error {
+	if !isKnownVariant(a.Variant) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Unknown variant %s", a.Variant))
+	}
+
+	return nil
+}
+
+func (a VariantAction) ProposalType() gov.ProposalKind {
+	return gov.ProposalTypeParameterChange
+}
+
+func (a VariantAction) Apply(ctx sdk.Context, k Keeper) sdk.Error {
+	p := k.GetParams(ctx)
+
+	variants := []string{}
+	for _, variant := range p.EnabledVariants {
+		if variant != a.Variant {
+			variants = append(variants, variant)
+		}
+	}
+
+	if a.Enabled {
+		variants = append(variants, a.Variant)
+	}
+
+	p.EnabledVariants = variants
+	if err := p.Validate(); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+
+	k.SetParams(ctx, p)
+
+	return nil
+}
+
+func (a VariantAction) String() string {
+	if a.Enabled {
+		return fmt.Sprintf("enable variant %s", a.Variant)
+	}
+
+	return fmt.Sprintf("disable variant %s", a.Variant)
+}
+
+// Pays out funds from the module treasury
+type TreasurySpendAction struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Coins      `json:"amount"`
+}
+
+func (a TreasurySpendAction) ValidateBasic() sdk.Error {
+	if a.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("Recipient is empty")
+	}
+
+	if !a.Amount.IsValid() || a.Amount.IsZero() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("Invalid amount %s", a.Amount))
+	}
+
+	return nil
+}
+
+func (a TreasurySpendAction) ProposalType() gov.ProposalKind {
+	return gov.ProposalTypeText
+}
+
+func (a TreasurySpendAction) Apply(ctx sdk.Context, k Keeper) sdk.Error {
+	if err := k.subtractCoins(ctx, TreasuryAddress, a.Amount); err != nil {
+		return err
+	}
+
+	k.addCoins(ctx, a.Recipient, a.Amount)
+
+	return nil
+}
+
+func (a TreasurySpendAction) String() string {
+	return fmt.Sprintf("send %s from the treasury to %s", a.Amount, a.Recipient)
+}
+
+// Funds the gas allowance of a game or tournament from the treasury
+type GasAllowanceAction struct {
+	Scope  string    `json:"scope"`
+	Id     uint      `json:"id"`
+	Amount sdk.Coins `json:"amount"`
+}
+
+func (a GasAllowanceAction) ValidateBasic() sdk.Error {
+	if !isKnownAllowanceScope(a.Scope) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Unknown allowance scope %s", a.Scope))
+	}
+
+	if !a.Amount.IsValid() || a.Amount.IsZero() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("Invalid amount %s", a.Amount))
+	}
+
+	return nil
+}
+
+func (a GasAllowanceAction) ProposalType() gov.ProposalKind {
+	return gov.ProposalTypeText
+}
+
+func (a GasAllowanceAction) Apply(ctx sdk.Context, k Keeper) sdk.Error {
+	if err := k.subtractCoins(ctx, TreasuryAddress, a.Amount); err != nil {
+		return err
+	}
+
+	return k.addGasAllowance(ctx, TreasuryAddress, a.Scope, a.Id, a.Amount)
+}
+
+func (a GasAllowanceAction) String() string {
+	return fmt.Sprintf("fund the gas of %s %d with %s from the treasury", a.Scope, a.Id, a.Amount)
+}
+
+// GameProposal is a gov proposal carrying an action for this module. Deposits,
+// voting and tallying are done by x/gov, the action is applied in our EndBlocker.
+type GameProposal struct {
+	gov.TextProposal `json:"proposal"`
+	Action           ProposalAction `json:"action"`
+}
+
+var _ gov.Proposal = (*GameProposal)(nil)
+
+func (p GameProposal) String() string {
+	return fmt.Sprintf("%s\n  Action:             %s", p.TextProposal.String(), p.Action)
+}
+
+func (k Keeper) SubmitProposal(ctx sdk.Context, title, description string, action ProposalAction,
+	proposer sdk.AccAddress, initialDeposit sdk.Coins) (uint64, sdk.Result) {
+
+	textProposal := k.govKeeper.NewTextProposal(ctx, title, description, action.ProposalType())
+	if textProposal == nil {
+		return 0, sdk.ErrInternal("Could not create proposal").Result()
+	}
+
+	proposal := &GameProposal{
+		TextProposal: *textProposal.(*gov.TextProposal),
+		Action:       action,
+	}
+	k.govKeeper.SetProposal(ctx, proposal)
+
+	proposalID := proposal.GetProposalID()
+	k.setPendingProposal(ctx, proposalID)
+
+	err, _ := k.govKeeper.AddDeposit(ctx, proposalID, proposer, initialDeposit)
+	if err != nil {
+		return 0, err.Result()
+	}
+
+	return proposalID, sdk.Result{}
+}
+
+func pendingProposalKey(proposalID uint64) []byte {
+	return []byte(fmt.Sprintf("proposal:%d", proposalID))
+}
+
+func (k Keeper) setPendingProposal(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(pendingProposalKey(proposalID), []byte(strconv.FormatUint(proposalID, 10)))
+}
+
+func (k Keeper) getPendingProposals(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, []byte("proposal:"))
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		id, err := strconv.ParseUint(string(iterator.Value()), 10, 64)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid proposal id: %v", iterator.Value()))
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func (k Keeper) deletePendingProposal(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(pendingProposalKey(proposalID))
+}
+
+// Applies game proposals which x/gov has passed and forgets the ones it rejected or dropped
+func (k Keeper) processProposals(ctx sdk.Context) sdk.Tags {
+	logger := ctx.Logger().With("module", "x/tictactoe")
+	resTags := sdk.NewTags()
+
+	for _, proposalID := range k.getPendingProposals(ctx) {
+		proposal := k.govKeeper.GetProposal(ctx, proposalID)
+		if proposal == nil {
+			k.deletePendingProposal(ctx, proposalID)
+			continue
+		}
+
+		switch proposal.GetStatus() {
+		case gov.StatusPassed:
+			k.deletePendingProposal(ctx, proposalID)
+
+			gameProposal, ok := proposal.(*GameProposal)
+			if !ok {
+				continue
+			}
+
+			// Changes are only written if the action succeeds
+			cacheCtx, write := ctx.CacheContext()
+			if err := gameProposal.Action.Apply(cacheCtx, k); err != nil {
+				logger.Info(fmt.Sprintf("proposal %d passed but could not be applied: %s", proposalID, err))
+				resTags = resTags.AppendTag(TagProposalApplied, "false")
+				continue
+			}
+
+			write()
+			logger.Info(fmt.Sprintf("proposal %d applied: %s", proposalID, gameProposal.Action))
+			resTags = resTags.AppendTag(TagProposalApplied, "true")
+		case gov.StatusRejected:
+			k.deletePendingProposal(ctx, proposalID)
+		}
+	}
+
+	return resTags
+}
+
+func registerProposalCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*ProposalAction)(nil), nil)
+	cdc.RegisterConcrete(ParamChangeAction{}, "tictactoe/ParamChangeAction", nil)
+	cdc.RegisterConcrete(VariantAction{}, "tictactoe/VariantAction", nil)
+	cdc.RegisterConcrete(TreasurySpendAction{}, "tictactoe/TreasurySpendAction", nil)
+	cdc.RegisterConcrete(GasAllowanceAction{}, "tictactoe/GasAllowanceAction", nil)
+	cdc.RegisterConcrete(&GameProposal{}, "tictactoe/GameProposal", nil)
+}
@@ -0,0 +1,85 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalActions(t *testing.T) {
+	tests := []struct {
+		name   string
+		action ProposalAction
+		ok     bool
+		// Checks the state after the action was applied, the treasury starts with 100abc
+		check func(t *testing.T, input testInput)
+	}{
+		{
+			name:   "param change",
+			action: ParamChangeAction{Key: "RakeRate", Value: `"0.05"`},
+			ok:     true,
+			check: func(t *testing.T, input testInput) {
+				require.True(t, sdk.NewDecWithPrec(5, 2).Equal(input.k.GetParams(input.ctx).RakeRate))
+			},
+		},
+		{
+			name:   "param change to an invalid value",
+			action: ParamChangeAction{Key: "RakeRate", Value: `"1.5"`},
+		},
+		{
+			name:   "param which does not exist",
+			action: ParamChangeAction{Key: "NoSuchParam", Value: `"1"`},
+		},
+		{
+			name:   "variant enabled",
+			action: VariantAction{Variant: VariantQuantum, Enabled: true},
+			ok:     true,
+			check: func(t *testing.T, input testInput) {
+				require.Equal(t, []string{VariantClassic, VariantQuantum}, input.k.GetParams(input.ctx).EnabledVariants)
+			},
+		},
+		{
+			name:   "variant disabled",
+			action: VariantAction{Variant: VariantClassic},
+			ok:     true,
+			check: func(t *testing.T, input testInput) {
+				require.Empty(t, input.k.GetParams(input.ctx).EnabledVariants)
+			},
+		},
+		{
+			name:   "treasury spend",
+			action: TreasurySpendAction{Recipient: testAddress("alice"), Amount: sdk.Coins{sdk.NewInt64Coin("abc", 40)}},
+			ok:     true,
+			check: func(t *testing.T, input testInput) {
+				require.Equal(t, "60abc", input.balance(TreasuryAddress).String())
+				require.Equal(t, "40abc", input.balance(testAddress("alice")).String())
+			},
+		},
+		{
+			name:   "treasury spend above its funds",
+			action: TreasurySpendAction{Recipient: testAddress("alice"), Amount: sdk.Coins{sdk.NewInt64Coin("abc", 101)}},
+		},
+		{
+			name:   "gas allowance of a game which does not exist",
+			action: GasAllowanceAction{Scope: AllowanceGame, Id: 7, Amount: sdk.Coins{sdk.NewInt64Coin("abc", 10)}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			treasury := input.ak.NewAccountWithAddress(input.ctx, TreasuryAddress)
+			require.NoError(t, treasury.SetCoins(mustParseCoins(t, "100abc")))
+			input.ak.SetAccount(input.ctx, treasury)
+
+			require.NoError(t, tc.action.ValidateBasic())
+			err := tc.action.Apply(input.ctx, input.k)
+			require.Equal(t, tc.ok, err == nil, "%v", err)
+
+			if tc.check != nil {
+				tc.check(t, input)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package tic_tac_toe
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PlayGrant lets another key sign MsgPlay for the seat of the granter in one game. It can not move
+// funds, the grantee only ever stands in for the granter in Play.
+type PlayGrant struct {
+	GameId  uint           `json:"game_id"`
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	// Last height the grant can be used at, 0 for no expiry
+	Expiry int64 `json:"expiry"`
+	// Moves the grantee can play, 0 for no limit
+	MaxMoves uint `json:"max_moves"`
+	Moves    uint `json:"moves"`
+}
+
+func (grant PlayGrant) usable(ctx sdk.Context) sdk.Error {
+	if grant.Expiry != 0 && ctx.BlockHeight() > grant.Expiry {
+		return sdk.ErrUnauthorized(fmt.Sprintf("Grant expired at height %d", grant.Expiry))
+	}
+
+	if grant.MaxMoves != 0 && grant.Moves >= grant.MaxMoves {
+		return sdk.ErrUnauthorized(fmt.Sprintf("Grant is used up after %d moves", grant.MaxMoves))
+	}
+
+	return nil
+}
+
+func playGrantPrefix(gameID uint) []byte {
+	return []byte(fmt.Sprintf("play_grant:%d:", gameID))
+}
+
+func playGrantKey(gameID uint, grantee sdk.AccAddress) []byte {
+	return append(playGrantPrefix(gameID), grantee.String()...)
+}
+
+func (k Keeper) storePlayGrant(ctx sdk.Context, grant *PlayGrant) {
+	store := ctx.KVStore(k.key)
+	store.Set(playGrantKey(grant.GameId, grant.Grantee), k.cdc.MustMarshalJSON(grant))
+}
+
+func (k Keeper) getPlayGrant(ctx sdk.Context, gameID uint, grantee sdk.AccAddress) *PlayGrant {
+	store := ctx.KVStore(k.key)
+	value := store.Get(playGrantKey(gameID, grantee))
+	if value == nil {
+		return nil
+	}
+
+	grant := new(PlayGrant)
+	if err := k.cdc.UnmarshalJSON(value, grant); err != nil {
+		panic(fmt.Sprintf("Invalid play grant stored: %s", err))
+	}
+
+	return grant
+}
+
+func (k Keeper) getPlayGrants(ctx sdk.Context, gameID uint) []PlayGrant {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, playGrantPrefix(gameID))
+	defer iterator.Close()
+
+	grants := []PlayGrant{}
+	for ; iterator.Valid(); iterator.Next() {
+		var grant PlayGrant
+		if err := k.cdc.UnmarshalJSON(iterator.Value(), &grant); err != nil {
+			panic(fmt.Sprintf("Invalid play grant stored: %s", err))
+		}
+
+		grants = append(grants, grant)
+	}
+
+	return grants
+}
+
+// Grants end with their game
+func (k Keeper) deletePlayGrants(ctx sdk.Context, gameID uint) {
+	store := ctx.KVStore(k.key)
+	for _, grant := range k.getPlayGrants(ctx, gameID) {
+		store.Delete(playGrantKey(gameID, grant.Grantee))
+	}
+}
+
+// GrantPlay lets the grantee play for the granter, granting again replaces the limits and resets the move count
+func (k Keeper) GrantPlay(ctx sdk.Context, gameID uint, granter, grantee sdk.AccAddress, expiry int64, maxMoves uint) sdk.Result {
+	game := k.getGame(ctx, gameID)
+	if game == nil {
+		return sdk.ErrUnknownRequest("No such game").Result()
+	}
+
+	if !game.Player1.Equals(granter) && !game.Player2.Equals(granter) {
+		return sdk.ErrUnauthorized("Not playing in this game").Result()
+	}
+
+	if game.Player1.Equals(grantee) || game.Player2.Equals(grantee) {
+		return sdk.ErrUnknownRequest("Can not grant to a player of the game").Result()
+	}
+
+	if game.Winner != WinnerNone {
+		return sdk.ErrUnknownRequest("Game already finished").Result()
+	}
+
+	if expiry != 0 && expiry < ctx.BlockHeight() {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Expiry %d is in the past", expiry)).Result()
+	}
+
+	if existing := k.getPlayGrant(ctx, gameID, grantee); existing != nil && !existing.Granter.Equals(granter) {
+		return sdk.ErrUnauthorized("Grantee already plays for the other seat").Result()
+	}
+
+	k.storePlayGrant(ctx, &PlayGrant{
+		GameId:   gameID,
+		Granter:  granter,
+		Grantee:  grantee,
+		Expiry:   expiry,
+		MaxMoves: maxMoves,
+	})
+
+	return sdk.Result{Tags: gameTags(game)}
+}
+
+func (k Keeper) RevokePlay(ctx sdk.Context, gameID uint, granter, grantee sdk.AccAddress) sdk.Result {
+	grant := k.getPlayGrant(ctx, gameID, grantee)
+	if grant == nil || !grant.Granter.Equals(granter) {
+		return sdk.ErrUnknownRequest("No such grant").Result()
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Delete(playGrantKey(gameID, grantee))
+
+	// Grants are only deleted with their game, so the game is still there
+	return sdk.Result{Tags: gameTags(k.getGame(ctx, gameID))}
+}
+
+// Seat a signer plays for, either their own or the one of a usable grant
+func (k Keeper) seatOf(ctx sdk.Context, game *Game, signer sdk.AccAddress) (sdk.AccAddress, sdk.Error) {
+	if game.Player1.Equals(signer) || game.Player2.Equals(signer) {
+		return signer, nil
+	}
+
+	grant := k.getPlayGrant(ctx, game.Id, signer)
+	if grant == nil {
+		return nil, sdk.ErrUnauthorized("Not playing in this game")
+	}
+
+	if err := grant.usable(ctx); err != nil {
+		return nil, err
+	}
+
+	return grant.Granter, nil
+}
+
+func (k Keeper) countGrantedMove(ctx sdk.Context, gameID uint, grantee sdk.AccAddress) {
+	grant := k.getPlayGrant(ctx, gameID, grantee)
+	if grant == nil {
+		return
+	}
+
+	grant.Moves++
+	k.storePlayGrant(ctx, grant)
+}
@@ -0,0 +1,124 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// An accepted game of alice against bob, alice moves first
+func startGrantGame(t *testing.T, input testInput) (*Game, sdk.AccAddress, sdk.AccAddress) {
+	alice := input.fund(t, "alice", "100abc")
+	bob := input.fund(t, "bob", "100abc")
+
+	game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic, sdk.Coins{}, sdk.Coins{}, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.AcceptGame(input.ctx, game.Id, bob).IsOK())
+
+	return game, alice, bob
+}
+
+func TestGrantPlay(t *testing.T) {
+	tests := []struct {
+		name    string
+		granter string
+		grantee string
+		ok      bool
+	}{
+		{"to another key", "alice", "carol", true},
+		{"to the opponent", "alice", "bob", false},
+		{"to yourself", "alice", "alice", false},
+		{"by somebody not playing", "carol", "dave", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			game, _, _ := startGrantGame(t, input)
+
+			res := input.k.GrantPlay(input.ctx, game.Id, testAddress(tc.granter), testAddress(tc.grantee), 0, 0)
+			require.Equal(t, tc.ok, res.IsOK(), res.Log)
+		})
+	}
+}
+
+func TestPlayGrantExpiry(t *testing.T) {
+	input := createTestInput(t)
+	game, alice, bob := startGrantGame(t, input)
+	carol := testAddress("carol")
+
+	height := input.ctx.BlockHeight()
+	require.True(t, input.k.GrantPlay(input.ctx, game.Id, alice, carol, height+1, 0).IsOK())
+
+	// The expiry is the last height the grant can be used at
+	require.True(t, input.k.Play(input.ctx.WithBlockHeight(height+1), game.Id, carol, 0).IsOK())
+	require.True(t, input.k.Play(input.ctx, game.Id, bob, 4).IsOK())
+	require.False(t, input.k.Play(input.ctx.WithBlockHeight(height+2), game.Id, carol, 8).IsOK())
+	require.True(t, input.k.Play(input.ctx.WithBlockHeight(height+2), game.Id, alice, 8).IsOK())
+
+	require.False(t, input.k.GrantPlay(input.ctx.WithBlockHeight(height+2), game.Id, alice, carol, height+1, 0).IsOK())
+}
+
+func TestPlayGrantMoveLimit(t *testing.T) {
+	input := createTestInput(t)
+	game, alice, bob := startGrantGame(t, input)
+	carol := testAddress("carol")
+
+	require.True(t, input.k.GrantPlay(input.ctx, game.Id, alice, carol, 0, 1).IsOK())
+	require.True(t, input.k.Play(input.ctx, game.Id, carol, 0).IsOK())
+	require.True(t, input.k.Play(input.ctx, game.Id, bob, 4).IsOK())
+	require.False(t, input.k.Play(input.ctx, game.Id, carol, 8).IsOK())
+	require.Equal(t, uint(1), input.k.getPlayGrant(input.ctx, game.Id, carol).Moves)
+
+	// Granting again resets the count
+	require.True(t, input.k.GrantPlay(input.ctx, game.Id, alice, carol, 0, 1).IsOK())
+	require.True(t, input.k.Play(input.ctx, game.Id, carol, 8).IsOK())
+
+	game = input.k.getGame(input.ctx, game.Id)
+	require.Equal(t, testFields(1, 0, 0, 0, 2, 0, 0, 0, 1), game.Fields)
+}
+
+func TestRevokePlay(t *testing.T) {
+	input := createTestInput(t)
+	game, alice, bob := startGrantGame(t, input)
+	carol := testAddress("carol")
+
+	require.True(t, input.k.GrantPlay(input.ctx, game.Id, alice, carol, 0, 0).IsOK())
+	require.False(t, input.k.RevokePlay(input.ctx, game.Id, bob, carol).IsOK())
+	require.True(t, input.k.RevokePlay(input.ctx, game.Id, alice, carol).IsOK())
+
+	require.False(t, input.k.Play(input.ctx, game.Id, carol, 0).IsOK())
+	require.Nil(t, input.k.getPlayGrant(input.ctx, game.Id, carol))
+	require.False(t, input.k.RevokePlay(input.ctx, game.Id, alice, carol).IsOK())
+}
+
+func TestGranteeOnlyPlays(t *testing.T) {
+	input := createTestInput(t)
+	alice := input.fund(t, "alice", "100abc")
+	bob := input.fund(t, "bob", "100abc")
+	carol := input.fund(t, "carol", "100abc")
+	handler := NewHandler(input.k)
+
+	// Grants already work on an invite, so the grantee could try to decline or accept it
+	game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic, mustParseCoins(t, "10abc"),
+		mustParseCoins(t, "10abc"), 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.GrantPlay(input.ctx, game.Id, alice, carol, 0, 0).IsOK())
+	require.True(t, input.k.GrantPlay(input.ctx, game.Id, bob, testAddress("dave"), 0, 0).IsOK())
+
+	for _, msg := range []sdk.Msg{
+		NewMsgCancelGame(game.Id, carol),
+		NewMsgAcceptGame(game.Id, testAddress("dave")),
+		NewMsgGrantPlay(game.Id, carol, testAddress("erin"), 0, 0),
+		NewMsgRevokePlay(game.Id, carol, testAddress("dave")),
+	} {
+		require.False(t, handler(input.ctx, msg).IsOK(), msg.Type())
+	}
+
+	require.True(t, handler(input.ctx, NewMsgAcceptGame(game.Id, bob)).IsOK())
+	require.True(t, handler(input.ctx, NewMsgPlay(game.Id, carol, 4)).IsOK())
+
+	require.Equal(t, mustParseCoins(t, "90abc"), input.balance(alice))
+	require.Equal(t, mustParseCoins(t, "100abc"), input.balance(carol))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"strconv"
 )
 
 func NewHandler(keeper Keeper) sdk.Handler {
@@ -13,6 +14,54 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgStartGame(ctx, keeper, msg)
 		case MsgPlay:
 			return handleMsgPlay(ctx, keeper, msg)
+		case MsgAcceptGame:
+			return handleMsgAcceptGame(ctx, keeper, msg)
+		case MsgCancelGame:
+			return handleMsgCancelGame(ctx, keeper, msg)
+		case MsgCommitToss:
+			return handleMsgCommitToss(ctx, keeper, msg)
+		case MsgRevealToss:
+			return handleMsgRevealToss(ctx, keeper, msg)
+		case MsgCommitMove:
+			return handleMsgCommitMove(ctx, keeper, msg)
+		case MsgRevealMove:
+			return handleMsgRevealMove(ctx, keeper, msg)
+		case MsgQuantumMove:
+			return handleMsgQuantumMove(ctx, keeper, msg)
+		case MsgCollapse:
+			return handleMsgCollapse(ctx, keeper, msg)
+		case MsgOpenChannel:
+			return handleMsgOpenChannel(ctx, keeper, msg)
+		case MsgCloseChannel:
+			return handleMsgCloseChannel(ctx, keeper, msg)
+		case MsgChallengeChannel:
+			return handleMsgChallengeChannel(ctx, keeper, msg)
+		case MsgForceMove:
+			return handleMsgForceMove(ctx, keeper, msg)
+		case MsgGrantPlay:
+			return handleMsgGrantPlay(ctx, keeper, msg)
+		case MsgRevokePlay:
+			return handleMsgRevokePlay(ctx, keeper, msg)
+		case MsgFundGasAllowance:
+			return handleMsgFundGasAllowance(ctx, keeper, msg)
+		case MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, keeper, msg)
+		case MsgCreateTournament:
+			return handleMsgCreateTournament(ctx, keeper, msg)
+		case MsgRegister:
+			return handleMsgRegister(ctx, keeper, msg)
+		case MsgStartTournament:
+			return handleMsgStartTournament(ctx, keeper, msg)
+		case MsgCancelTournament:
+			return handleMsgCancelTournament(ctx, keeper, msg)
+		case MsgCreateLeague:
+			return handleMsgCreateLeague(ctx, keeper, msg)
+		case MsgJoinLeague:
+			return handleMsgJoinLeague(ctx, keeper, msg)
+		case MsgSponsorLeague:
+			return handleMsgSponsorLeague(ctx, keeper, msg)
+		case MsgStartSeason:
+			return handleMsgStartSeason(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized tic tac toe Msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -21,8 +70,55 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgStartGame(ctx sdk.Context, keeper Keeper, msg MsgStartGame) sdk.Result {
-	game, res := keeper.StartGame(ctx, msg.Inviter, msg.Opponent, msg.Amount)
-	if game == nil  {
+	if msg.SeriesLength > 1 {
+		return handleStartMatch(ctx, keeper, msg)
+	}
+
+	if msg.HouseLevel != 0 {
+		return handleStartHouseGame(ctx, keeper, msg)
+	}
+
+	game, res := keeper.InviteGame(ctx, msg.Inviter, msg.Opponent, msg.Variant, msg.InviterAmount, msg.OpponentAmount, msg.MoveTimeout)
+	if game == nil {
+		return res
+	}
+
+	if len(msg.TossCommitment) > 0 {
+		keeper.startToss(ctx, game, msg.TossCommitment)
+	}
+
+	gameData, err := json.Marshal(game)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.Result{Data: gameData, Tags: turnTags(game)}
+}
+
+func handleStartMatch(ctx sdk.Context, keeper Keeper, msg MsgStartGame) sdk.Result {
+	match, res := keeper.StartMatch(ctx, msg.Inviter, msg.Opponent, msg.Variant, msg.InviterAmount, msg.OpponentAmount,
+		msg.MoveTimeout, msg.SeriesLength, msg.TossCommitment)
+	if match == nil {
+		return res
+	}
+
+	matchData, err := json.Marshal(match)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.Result{
+		Data: matchData,
+		Tags: sdk.NewTags(
+			TagMatchId, strconv.Itoa(int(match.Id)),
+			TagMatchGame, strconv.Itoa(int(match.Games[0])),
+		).AppendTags(res.Tags),
+	}
+}
+
+func handleStartHouseGame(ctx sdk.Context, keeper Keeper, msg MsgStartGame) sdk.Result {
+	game, res := keeper.StartHouseGame(ctx, msg.Inviter, msg.HouseLevel, msg.InviterAmount, msg.OpponentAmount, msg.MoveTimeout)
+	if game == nil {
 		return res
 	}
 
@@ -31,9 +127,153 @@ func handleMsgStartGame(ctx sdk.Context, keeper Keeper, msg MsgStartGame) sdk.Re
 		panic(err)
 	}
 
-	return sdk.Result{Data: gameData}
+	return sdk.Result{Data: gameData, Tags: turnTags(game)}
 }
 
 func handleMsgPlay(ctx sdk.Context, keeper Keeper, msg MsgPlay) sdk.Result {
 	return keeper.Play(ctx, msg.GameId, msg.Player, msg.Field)
 }
+
+func handleMsgAcceptGame(ctx sdk.Context, keeper Keeper, msg MsgAcceptGame) sdk.Result {
+	return keeper.AcceptGame(ctx, msg.GameId, msg.Opponent)
+}
+
+func handleMsgCancelGame(ctx sdk.Context, keeper Keeper, msg MsgCancelGame) sdk.Result {
+	return keeper.CancelGame(ctx, msg.GameId, msg.Player)
+}
+
+func handleMsgCommitToss(ctx sdk.Context, keeper Keeper, msg MsgCommitToss) sdk.Result {
+	return keeper.CommitToss(ctx, msg.GameId, msg.Player, msg.Commitment)
+}
+
+func handleMsgRevealToss(ctx sdk.Context, keeper Keeper, msg MsgRevealToss) sdk.Result {
+	return keeper.RevealToss(ctx, msg.GameId, msg.Player, msg.Secret)
+}
+
+func handleMsgCommitMove(ctx sdk.Context, keeper Keeper, msg MsgCommitMove) sdk.Result {
+	return keeper.CommitMove(ctx, msg.GameId, msg.Player, msg.Commitment)
+}
+
+func handleMsgRevealMove(ctx sdk.Context, keeper Keeper, msg MsgRevealMove) sdk.Result {
+	return keeper.RevealMove(ctx, msg.GameId, msg.Player, msg.Field, msg.Salt)
+}
+
+func handleMsgQuantumMove(ctx sdk.Context, keeper Keeper, msg MsgQuantumMove) sdk.Result {
+	return keeper.QuantumMove(ctx, msg.GameId, msg.Player, msg.Field1, msg.Field2)
+}
+
+func handleMsgCollapse(ctx sdk.Context, keeper Keeper, msg MsgCollapse) sdk.Result {
+	return keeper.Collapse(ctx, msg.GameId, msg.Player, msg.Field)
+}
+
+func handleMsgOpenChannel(ctx sdk.Context, keeper Keeper, msg MsgOpenChannel) sdk.Result {
+	game, res := keeper.OpenChannel(ctx, msg.Inviter, msg.Opponent, msg.InviterAmount, msg.OpponentAmount)
+	if game == nil {
+		return res
+	}
+
+	gameData, err := json.Marshal(game)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.Result{Data: gameData, Tags: turnTags(game)}
+}
+
+func handleMsgCloseChannel(ctx sdk.Context, keeper Keeper, msg MsgCloseChannel) sdk.Result {
+	return keeper.CloseChannel(ctx, msg.GameId, msg.Sender, msg.State, msg.Signature1, msg.Signature2, msg.PubKey1,
+		msg.PubKey2)
+}
+
+func handleMsgChallengeChannel(ctx sdk.Context, keeper Keeper, msg MsgChallengeChannel) sdk.Result {
+	return keeper.ChallengeChannel(ctx, msg.GameId, msg.Sender, msg.State, msg.Signature1, msg.Signature2, msg.PubKey1,
+		msg.PubKey2)
+}
+
+func handleMsgForceMove(ctx sdk.Context, keeper Keeper, msg MsgForceMove) sdk.Result {
+	return keeper.ForceMove(ctx, msg.GameId, msg.Player, msg.Field)
+}
+
+func handleMsgGrantPlay(ctx sdk.Context, keeper Keeper, msg MsgGrantPlay) sdk.Result {
+	return keeper.GrantPlay(ctx, msg.GameId, msg.Granter, msg.Grantee, msg.Expiry, msg.MaxMoves)
+}
+
+func handleMsgRevokePlay(ctx sdk.Context, keeper Keeper, msg MsgRevokePlay) sdk.Result {
+	return keeper.RevokePlay(ctx, msg.GameId, msg.Granter, msg.Grantee)
+}
+
+func handleMsgFundGasAllowance(ctx sdk.Context, keeper Keeper, msg MsgFundGasAllowance) sdk.Result {
+	return keeper.FundGasAllowance(ctx, msg.Sponsor, msg.Scope, msg.Id, msg.Amount)
+}
+
+func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
+	proposalID, res := keeper.SubmitProposal(ctx, msg.Title, msg.Description, msg.Action, msg.Proposer, msg.InitialDeposit)
+	if !res.IsOK() {
+		return res
+	}
+
+	return sdk.Result{
+		Data: []byte(strconv.FormatUint(proposalID, 10)),
+		Tags: sdk.NewTags(TagProposalId, strconv.FormatUint(proposalID, 10)),
+	}
+}
+
+func handleMsgCreateTournament(ctx sdk.Context, keeper Keeper, msg MsgCreateTournament) sdk.Result {
+	tournament, res := keeper.CreateTournament(ctx, msg.Organiser, msg.Format, msg.Variant, msg.EntryFee,
+		msg.PrizeShares, msg.MaxEntrants, msg.MoveTimeout)
+	if tournament == nil {
+		return res
+	}
+
+	tournamentData, err := json.Marshal(tournament)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.Result{
+		Data: tournamentData,
+		Tags: sdk.NewTags(TagTournamentId, strconv.Itoa(int(tournament.Id))),
+	}
+}
+
+func handleMsgRegister(ctx sdk.Context, keeper Keeper, msg MsgRegister) sdk.Result {
+	return keeper.Register(ctx, msg.TournamentId, msg.Player)
+}
+
+func handleMsgStartTournament(ctx sdk.Context, keeper Keeper, msg MsgStartTournament) sdk.Result {
+	return keeper.StartTournament(ctx, msg.TournamentId, msg.Organiser)
+}
+
+func handleMsgCancelTournament(ctx sdk.Context, keeper Keeper, msg MsgCancelTournament) sdk.Result {
+	return keeper.CancelTournament(ctx, msg.TournamentId, msg.Organiser)
+}
+
+func handleMsgCreateLeague(ctx sdk.Context, keeper Keeper, msg MsgCreateLeague) sdk.Result {
+	league, res := keeper.CreateLeague(ctx, msg.Organiser, msg.Format, msg.Variant, msg.PrizeShares,
+		msg.MaxPlayers, msg.SwissRounds, msg.RoundInterval, msg.MoveTimeout)
+	if league == nil {
+		return res
+	}
+
+	leagueData, err := json.Marshal(league)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.Result{
+		Data: leagueData,
+		Tags: sdk.NewTags(TagLeagueId, strconv.Itoa(int(league.Id))),
+	}
+}
+
+func handleMsgJoinLeague(ctx sdk.Context, keeper Keeper, msg MsgJoinLeague) sdk.Result {
+	return keeper.JoinLeague(ctx, msg.LeagueId, msg.Player)
+}
+
+func handleMsgSponsorLeague(ctx sdk.Context, keeper Keeper, msg MsgSponsorLeague) sdk.Result {
+	return keeper.Sponsor(ctx, msg.LeagueId, msg.Sponsor, msg.Amount)
+}
+
+func handleMsgStartSeason(ctx sdk.Context, keeper Keeper, msg MsgStartSeason) sdk.Result {
+	return keeper.StartSeason(ctx, msg.LeagueId, msg.Organiser)
+}
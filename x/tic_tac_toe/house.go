@@ -0,0 +1,165 @@
+package tic_tac_toe
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// The house plays against anyone who starts a game with the treasury as opponent. It answers every
+// move in the same transaction and its stakes come from the treasury.
+var HouseAddress = TreasuryAddress
+
+// Difficulty of the house, easier levels now and then play a random field instead of the best one
+const (
+	HouseEasy    uint = 1
+	HouseMedium  uint = 2
+	HousePerfect uint = 3
+)
+
+var houseBlunderPercent = map[uint]int{
+	HouseEasy:    50,
+	HouseMedium:  20,
+	HousePerfect: 0,
+}
+
+func isKnownHouseLevel(level uint) bool {
+	_, ok := houseBlunderPercent[level]
+	return ok
+}
+
+// StartHouseGame starts a classic game against the house, which never stakes more than the player.
+// The blunders of the easier levels can be foreseen by the player, so they only play without stakes.
+func (k Keeper) StartHouseGame(ctx sdk.Context, player sdk.AccAddress, level uint, amount, houseAmount sdk.Coins,
+	moveTimeout int64) (*Game, sdk.Result) {
+	if !amount.IsAllGTE(houseAmount) {
+		return nil, sdk.ErrUnknownRequest("The house does not give odds, its stake can be at most yours").Result()
+	}
+
+	if level != HousePerfect && (!amount.IsZero() || !houseAmount.IsZero()) {
+		return nil, sdk.ErrUnknownRequest("Only the perfect house plays for stakes").Result()
+	}
+
+	// Without a time limit a losing player could stop moving and keep the stake of the treasury in escrow
+	if moveTimeout <= 0 && (!amount.IsZero() || !houseAmount.IsZero()) {
+		return nil, sdk.ErrUnknownRequest("Games against the house for stakes need a move timeout").Result()
+	}
+
+	game, res := k.StartGame(ctx, player, HouseAddress, VariantClassic, amount, houseAmount, moveTimeout)
+	if game == nil {
+		return nil, res
+	}
+
+	game.HouseLevel = level
+	k.storeGame(ctx, game)
+
+	return game, sdk.Result{}
+}
+
+// Minimax score of a board for the player to move, wins which come sooner score higher
+func minimax(fields map[string]uint, toMove uint, depth int) int {
+	switch winner := WinnerOf(fields); winner {
+	case WinnerNone:
+	case WinnerDraw:
+		return 0
+	case toMove:
+		return 10 - depth
+	default:
+		return depth - 10
+	}
+
+	best := -100
+	for i := 0; i < 9; i++ {
+		field := strconv.Itoa(i)
+		if fields[field] != 0 {
+			continue
+		}
+
+		fields[field] = toMove
+		score := -minimax(fields, otherPlayer(toMove), depth+1)
+		fields[field] = 0
+
+		if score > best {
+			best = score
+		}
+	}
+
+	return best
+}
+
+// Best field for the player, the lowest one if several are equally good
+func bestMove(fields map[string]uint, player uint) uint {
+	board := make(map[string]uint)
+	for field, mark := range fields {
+		board[field] = mark
+	}
+
+	best, bestScore := uint(0), -101
+	for i := 0; i < 9; i++ {
+		field := strconv.Itoa(i)
+		if board[field] != 0 {
+			continue
+		}
+
+		board[field] = player
+		score := -minimax(board, otherPlayer(player), 1)
+		board[field] = 0
+
+		if score > bestScore {
+			best, bestScore = uint(i), score
+		}
+	}
+
+	return best
+}
+
+// Every validator derives the same seed, it changes with the block and the move. The player can work it
+// out before moving, which is why the blunders are only played in games without stakes.
+func houseSeed(ctx sdk.Context, game *Game) []byte {
+	bz := append([]byte{}, ctx.BlockHeader().LastBlockId.Hash...)
+	bz = append(bz, uint64Bytes(uint64(game.Id))...)
+	bz = append(bz, uint64Bytes(uint64(totalMoves(game.Fields)))...)
+
+	return tmhash.Sum(bz)
+}
+
+func houseMove(ctx sdk.Context, game *Game, player uint) uint {
+	seed := houseSeed(ctx, game)
+	if int(seed[0])%100 >= houseBlunderPercent[game.HouseLevel] {
+		return bestMove(game.Fields, player)
+	}
+
+	var free []uint
+	for i := 0; i < 9; i++ {
+		if !isFieldTaken(game.Fields, strconv.Itoa(i)) {
+			free = append(free, uint(i))
+		}
+	}
+
+	return free[int(seed[1])%len(free)]
+}
+
+// Answers the move of the player, the game has to be running with the house to move
+func (k Keeper) playHouse(ctx sdk.Context, game *Game) sdk.Tags {
+	mark := game.PlayerToMove()
+	field := houseMove(ctx, game, mark)
+	game.Fields[strconv.Itoa(int(field))] = mark
+	game.Moves = append(game.Moves, field)
+
+	resTags := sdk.NewTags(TagHouseMove, fmt.Sprint(field))
+
+	checkWinner(game)
+	if game.Winner == WinnerNone && totalMoves(game.Fields) == len(game.Fields) {
+		game.Winner = WinnerDraw
+	}
+
+	if game.Winner != WinnerNone {
+		return resTags.AppendTags(k.finishGame(ctx, game))
+	}
+
+	k.resetDeadline(ctx, game)
+
+	return resTags
+}
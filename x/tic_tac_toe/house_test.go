@@ -0,0 +1,68 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartHouseGame(t *testing.T) {
+	tests := []struct {
+		name        string
+		level       uint
+		amount      string
+		houseAmount string
+		moveTimeout int64
+		ok          bool
+	}{
+		{"perfect for stakes", HousePerfect, "10abc", "10abc", 10, true},
+		{"perfect for nothing", HousePerfect, "", "", 0, true},
+		{"easy for nothing", HouseEasy, "", "", 0, true},
+		{"easy for stakes", HouseEasy, "10abc", "10abc", 10, false},
+		{"medium for the stake of the player", HouseMedium, "10abc", "", 10, false},
+		{"house gives no odds", HousePerfect, "10abc", "20abc", 10, false},
+		{"stakes without a move timeout", HousePerfect, "10abc", "10abc", 0, false},
+		{"stake of the player without a move timeout", HousePerfect, "10abc", "", 0, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			alice := input.fund(t, "alice", "100abc")
+
+			treasury := input.ak.NewAccountWithAddress(input.ctx, HouseAddress)
+			require.NoError(t, treasury.SetCoins(mustParseCoins(t, "100abc")))
+			input.ak.SetAccount(input.ctx, treasury)
+
+			game, res := input.k.StartHouseGame(input.ctx, alice, tc.level, mustParseCoins(t, tc.amount),
+				mustParseCoins(t, tc.houseAmount), tc.moveTimeout)
+			require.Equal(t, tc.ok, res.IsOK(), res.Log)
+			if tc.ok {
+				require.Equal(t, tc.level, game.HouseLevel)
+			} else {
+				require.Equal(t, mustParseCoins(t, "100abc"), input.balance(alice))
+			}
+		})
+	}
+}
+
+func TestBestMove(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]uint
+		player uint
+		field  uint
+	}{
+		{"completes its line", testFields(2, 2, 0, 1, 1, 0, 1, 0, 0), 2, 2},
+		{"wins before blocking", testFields(1, 1, 0, 2, 2, 0, 0, 0, 0), 1, 2},
+		{"blocks a line", testFields(1, 1, 0, 0, 2, 0, 0, 0, 0), 2, 2},
+		{"blocks the fork of opposite corners", testFields(1, 0, 0, 0, 2, 0, 0, 0, 1), 2, 1},
+		{"takes the centre against a corner", testFields(1), 2, 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.field, bestMove(tc.fields, tc.player))
+		})
+	}
+}
@@ -5,20 +5,28 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"strconv"
+	"tic_tac_toe/solver"
 )
 
 type Keeper struct {
 	accountKeeper auth.AccountKeeper
-	key sdk.StoreKey
-	cdc *codec.Codec
+	govKeeper     gov.Keeper
+	paramSpace    params.Subspace
+	key           sdk.StoreKey
+	cdc           *codec.Codec
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, accountKeeper auth.AccountKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, accountKeeper auth.AccountKeeper, govKeeper gov.Keeper,
+	paramSpace params.Subspace) Keeper {
 	return Keeper{
-		cdc: cdc,
-		key: key,
+		cdc:           cdc,
+		key:           key,
 		accountKeeper: accountKeeper,
+		govKeeper:     govKeeper,
+		paramSpace:    paramSpace.WithKeyTable(ParamKeyTable()),
 	}
 }
 
@@ -43,23 +51,60 @@ func (k Keeper) getGameId(ctx sdk.Context) int {
 	return id
 }
 
+// GameKey is the store key of a game. Querying it under /store/tictactoe/key returns a Merkle proof of the
+// game against the app hash, which the light client checks when the node is not trusted.
+func GameKey(id uint) []byte {
+	return []byte(strconv.Itoa(int(id)))
+}
+
 func (k Keeper) storeGame(ctx sdk.Context, game *Game) {
 	store := ctx.KVStore(k.key)
-	key := strconv.Itoa(int(game.Id))
 	value := k.cdc.MustMarshalJSON(game)
-	store.Set([]byte(key), value)
+	store.Set(GameKey(game.Id), value)
+
+	for _, player := range []sdk.AccAddress{game.Player1, game.Player2} {
+		if !player.Empty() {
+			store.Set(playerGameKey(player, game.Id), []byte{})
+		}
+	}
+}
+
+// Index of the games of a player, ids are padded so they iterate in order
+func playerGamePrefix(player sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("player_game:%s:", player))
+}
+
+func playerGameKey(player sdk.AccAddress, id uint) []byte {
+	return append(playerGamePrefix(player), fmt.Sprintf("%010d", id)...)
+}
+
+// Ids of the games a player is part of, oldest first
+func (k Keeper) getPlayerGames(ctx sdk.Context, player sdk.AccAddress) []uint {
+	store := ctx.KVStore(k.key)
+	prefix := playerGamePrefix(player)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	ids := []uint{}
+	for ; iterator.Valid(); iterator.Next() {
+		id, err := strconv.Atoi(string(iterator.Key()[len(prefix):]))
+		if err != nil {
+			panic(fmt.Sprintf("Invalid player game key: %s", iterator.Key()))
+		}
+
+		ids = append(ids, uint(id))
+	}
+
+	return ids
 }
 
 func (k Keeper) getGame(ctx sdk.Context, id uint) *Game {
 	store := ctx.KVStore(k.key)
-	key := strconv.Itoa(int(id))
-	value := store.Get([]byte(key))
+	value := store.Get(GameKey(id))
 	if value == nil {
 		return nil
 	}
 
-	fmt.Printf("Value in keeper: %s\n\n", value)
-
 	game := new(Game)
 	err := k.cdc.UnmarshalJSON(value, game)
 	if err != nil {
@@ -69,85 +114,237 @@ func (k Keeper) getGame(ctx sdk.Context, id uint) *Game {
 	return game
 }
 
-func (k Keeper) StartGame(ctx sdk.Context, player1, player2 sdk.AccAddress, amount sdk.Coin) (*Game, sdk.Result) {
-	if !amount.IsZero() {
-		acc1 := k.accountKeeper.GetAccount(ctx, player1)
-		if acc1 == nil {
-			return nil, sdk.ErrInvalidAddress("No player 1 account").Result()
-		}
+func (k Keeper) StartGame(ctx sdk.Context, player1, player2 sdk.AccAddress, variant string, amount1, amount2 sdk.Coins,
+	moveTimeout int64) (*Game, sdk.Result) {
+	if err := k.validateStakes(ctx, variant, amount1, amount2); err != nil {
+		return nil, err.Result()
+	}
 
-		acc2 := k.accountKeeper.GetAccount(ctx, player2)
-		if acc2 == nil {
-			return nil, sdk.ErrInvalidAddress("No player 2 account").Result()
-		}
+	if err := k.escrowStakes(ctx, player1, player2, amount1, amount2); err != nil {
+		return nil, err.Result()
+	}
 
-		amountCoins := sdk.Coins{amount}
-		coins1 := acc1.GetCoins()
-		if !coins1.IsAllGTE(amountCoins) {
-			return nil, sdk.ErrInsufficientCoins("Player 1 has not enough tokens").Result()
-		}
+	return k.createGame(ctx, player1, player2, variant, amount1, amount2, moveTimeout), sdk.Result{}
+}
 
-		coins2 := acc2.GetCoins()
-		if !coins2.IsAllGTE(amountCoins) {
-			return nil, sdk.ErrInsufficientCoins("Player 2 has not enough tokens").Result()
+// InviteGame starts a game the opponent still has to accept. Only the stake of the inviter is taken,
+// the stake of the opponent is taken when they accept.
+func (k Keeper) InviteGame(ctx sdk.Context, inviter, opponent sdk.AccAddress, variant string, amount1, amount2 sdk.Coins,
+	moveTimeout int64) (*Game, sdk.Result) {
+	if err := k.validateStakes(ctx, variant, amount1, amount2); err != nil {
+		return nil, err.Result()
+	}
+
+	if !amount1.IsZero() {
+		if err := k.subtractCoins(ctx, inviter, amount1); err != nil {
+			return nil, err.Result()
 		}
+	}
 
-		newCoins1 := coins1.Sub(amountCoins)
-		newCoins2 := coins2.Sub(amountCoins)
+	game := k.createGame(ctx, inviter, opponent, variant, amount1, amount2, moveTimeout)
+	k.awaitAccept(ctx, game)
 
-		if err := acc1.SetCoins(newCoins1); err != nil {
-			panic(err)
-		}
+	return game, sdk.Result{}
+}
+
+func (k Keeper) awaitAccept(ctx sdk.Context, game *Game) {
+	game.AwaitingAccept = true
+	k.resetDeadline(ctx, game)
+	k.storeGame(ctx, game)
+}
 
-		if err := acc2.SetCoins(newCoins2); err != nil {
-			panic(err)
+// AcceptGame takes the stake of the opponent, of the whole series for the first game of a match, and
+// starts the clock
+func (k Keeper) AcceptGame(ctx sdk.Context, gameID uint, opponent sdk.AccAddress) sdk.Result {
+	game := k.getGame(ctx, gameID)
+	if game == nil {
+		return sdk.ErrUnknownRequest("No such game").Result()
+	}
+
+	if !game.Player2.Equals(opponent) {
+		return sdk.ErrUnauthorized("Only the invited player can accept").Result()
+	}
+
+	if !game.AwaitingAccept || game.Winner != WinnerNone {
+		return sdk.ErrUnknownRequest("Game is not waiting to be accepted").Result()
+	}
+
+	stake := game.Amount2
+	if game.MatchId != 0 {
+		stake = k.getMatch(ctx, game.MatchId).Amount2
+	}
+
+	if !stake.IsZero() {
+		if err := k.subtractCoins(ctx, opponent, stake); err != nil {
+			return err.Result()
 		}
+	}
+
+	game.AwaitingAccept = false
+	k.resetDeadline(ctx, game)
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: turnTags(game)}
+}
+
+// CancelGame withdraws or declines an invite, the inviter gets the stake back
+func (k Keeper) CancelGame(ctx sdk.Context, gameID uint, player sdk.AccAddress) sdk.Result {
+	game := k.getGame(ctx, gameID)
+	if game == nil {
+		return sdk.ErrUnknownRequest("No such game").Result()
+	}
 
-		k.accountKeeper.SetAccount(ctx, acc1)
-		k.accountKeeper.SetAccount(ctx, acc2)
+	if !game.Player1.Equals(player) && !game.Player2.Equals(player) {
+		return sdk.ErrUnauthorized("Not playing in this game").Result()
+	}
+
+	if !game.AwaitingAccept || game.Winner != WinnerNone {
+		return sdk.ErrUnknownRequest("Only an invite which was not accepted yet can be cancelled").Result()
 	}
 
+	game.Winner = WinnerCancelled
+	resTags := k.finishGame(ctx, game)
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: gameTags(game).AppendTags(resTags)}
+}
+
+// Stores a new game, stakes have to be escrowed already
+func (k Keeper) createGame(ctx sdk.Context, player1, player2 sdk.AccAddress, variant string, amount1, amount2 sdk.Coins,
+	moveTimeout int64) *Game {
 	nextGameID := k.getGameId(ctx) + 1
 	k.setGameId(ctx, uint(nextGameID))
 	game := &Game{
 		Id:      uint(nextGameID),
+		Variant: variant,
 		Player1: player1,
 		Player2: player2,
 		Fields:  emptyFields(),
-		Amount: amount,
+		Amount1: amount1,
+		Amount2: amount2,
 		Winner:  0,
+
+		MoveTimeout: moveTimeout,
+		FirstPlayer: 1,
 	}
 
+	if variant == VariantQuantum {
+		game.Quantum = newQuantumBoard()
+	}
+
+	k.resetDeadline(ctx, game)
 	k.storeGame(ctx, game)
 
-	return game, sdk.Result{}
+	return game
 }
 
-func (k Keeper) Play(ctx sdk.Context, gameID uint, player sdk.AccAddress, field uint) sdk.Result {
+// Checks the variant can be played and the stakes are within the params
+func (k Keeper) validateStakes(ctx sdk.Context, variant string, amount1, amount2 sdk.Coins) sdk.Error {
+	p := k.GetParams(ctx)
+	if !p.IsVariantEnabled(variant) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Variant %s is not enabled", variant))
+	}
+
+	for _, amount := range append(amount1, amount2...) {
+		if !p.IsDenomAllowed(amount.Denom) {
+			return sdk.ErrInvalidCoins(fmt.Sprintf("Can not bet in %s", amount.Denom))
+		}
+
+		maxStake := p.MaxStake.AmountOf(amount.Denom)
+		if !maxStake.IsZero() && amount.Amount.GT(maxStake) {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Stake can be at most %s%s", maxStake, amount.Denom))
+		}
+	}
+
+	return nil
+}
+
+// Takes the stakes from both players until the game is settled
+func (k Keeper) escrowStakes(ctx sdk.Context, player1, player2 sdk.AccAddress, amount1, amount2 sdk.Coins) sdk.Error {
+	if amount1.IsZero() && amount2.IsZero() {
+		return nil
+	}
+
+	acc1 := k.accountKeeper.GetAccount(ctx, player1)
+	if acc1 == nil {
+		return sdk.ErrInvalidAddress("No player 1 account")
+	}
+
+	acc2 := k.accountKeeper.GetAccount(ctx, player2)
+	if acc2 == nil {
+		return sdk.ErrInvalidAddress("No player 2 account")
+	}
+
+	coins1 := acc1.GetCoins()
+	if !coins1.IsAllGTE(amount1) {
+		return sdk.ErrInsufficientCoins("Player 1 has not enough tokens")
+	}
+
+	coins2 := acc2.GetCoins()
+	if !coins2.IsAllGTE(amount2) {
+		return sdk.ErrInsufficientCoins("Player 2 has not enough tokens")
+	}
+
+	newCoins1 := coins1.Sub(amount1)
+	newCoins2 := coins2.Sub(amount2)
+
+	if err := acc1.SetCoins(newCoins1); err != nil {
+		panic(err)
+	}
+
+	if err := acc2.SetCoins(newCoins2); err != nil {
+		panic(err)
+	}
+
+	k.accountKeeper.SetAccount(ctx, acc1)
+	k.accountKeeper.SetAccount(ctx, acc2)
+
+	return nil
+}
+
+// Play places a mark for the player, the signer can also be a key the player granted to play for them
+func (k Keeper) Play(ctx sdk.Context, gameID uint, signer sdk.AccAddress, field uint) sdk.Result {
 	game := k.getGame(ctx, gameID)
 	if game == nil {
 		return sdk.ErrUnknownRequest("No such game").Result()
 	}
 
-	if !game.Player1.Equals(player) && !game.Player2.Equals(player) {
-		return sdk.ErrUnauthorized("Not playing in this game").Result()
+	player, err := k.seatOf(ctx, game, signer)
+	if err != nil {
+		return err.Result()
 	}
 
 	if game.Winner != 0 {
 		return sdk.ErrUnknownRequest("Game already finished").Result()
 	}
 
+	if game.AwaitingAccept {
+		return sdk.ErrUnknownRequest("The invite is not accepted yet").Result()
+	}
+
 	var player1 bool
 	if game.Player1.Equals(player) {
 		player1 = true
 	}
 
-	var player1ShouldPlay bool
-	movesPlayed := totalMoves(game.Fields)
-	if movesPlayed%2 == 0 {
-		player1ShouldPlay = true
+	if isBlindVariant(game.Variant) {
+		return sdk.ErrUnknownRequest("Moves of blind games are committed and revealed").Result()
+	}
+
+	if game.Quantum != nil {
+		return sdk.ErrUnknownRequest("Quantum games are played with spooky marks").Result()
+	}
+
+	if game.Channel != nil {
+		return sdk.ErrUnknownRequest("Channel games are played off-chain, a move can only be forced after a unilateral close").Result()
+	}
+
+	if game.FirstPlayer == 0 {
+		return sdk.ErrUnknownRequest("The toss for the first move is not decided yet").Result()
 	}
 
+	player1ShouldPlay := game.PlayerToMove() == 1
+
 	if (player1 && !player1ShouldPlay) || (!player1 && player1ShouldPlay) {
 		return sdk.ErrUnknownRequest("Not your turn").Result()
 	}
@@ -166,35 +363,186 @@ func (k Keeper) Play(ctx sdk.Context, gameID uint, player sdk.AccAddress, field
 	}
 
 	game.Fields[fieldStr] = mark
+	game.Moves = append(game.Moves, field)
+
+	if !signer.Equals(player) {
+		k.countGrantedMove(ctx, game.Id, signer)
+	}
 
+	var resTags sdk.Tags
 	checkWinner(game)
-	if game.Winner != 0 && !game.Amount.IsZero() {
-		k.distributeReward(ctx, game)
+	if game.Winner == WinnerNone && totalMoves(game.Fields) == len(game.Fields) {
+		game.Winner = WinnerDraw
+	}
+
+	if game.Winner != WinnerNone {
+		resTags = k.finishGame(ctx, game)
+	} else if game.HouseLevel != 0 {
+		resTags = k.playHouse(ctx, game)
+	} else {
+		k.resetDeadline(ctx, game)
 	}
 
 	k.storeGame(ctx, game)
 
-	return sdk.Result{}
+	return sdk.Result{Tags: turnTags(game).AppendTags(resTags)}
 }
 
-func (k Keeper) distributeReward(ctx sdk.Context, game *Game) {
-	var acc auth.Account
-	if game.Winner == 1 {
-		acc = k.accountKeeper.GetAccount(ctx, game.Player1)
-	} else {
-		acc = k.accountKeeper.GetAccount(ctx, game.Player2)
+// Lets clients subscribe to the moves they have to make, a game which is over or waits for a toss has no next player
+func turnTags(game *Game) sdk.Tags {
+	resTags := gameTags(game)
+	if game.Winner == WinnerNone && game.AwaitingAccept {
+		resTags = resTags.AppendTag(TagInvitee, game.Player2.String())
+	}
+
+	if game.Winner == WinnerNone && game.PlayerToMove() != 0 {
+		resTags = resTags.AppendTag(TagNextPlayer, game.Player(game.PlayerToMove()).String())
 	}
 
-	reward := sdk.Coins{game.Amount}
-	reward = reward.Add(reward)
-	currentCoins := acc.GetCoins()
-	newCoins := currentCoins.Add(reward)
+	return resTags
+}
+
+// Makes every action of a game searchable by game, player and stake, like --tags 'player:cosmos1...'
+func gameTags(game *Game) sdk.Tags {
+	resTags := sdk.NewTags(
+		TagGameId, strconv.Itoa(int(game.Id)),
+		TagPlayer, game.Player1.String(),
+		TagPlayer, game.Player2.String(),
+	)
+
+	for _, coin := range game.Pot() {
+		resTags = resTags.AppendTags(sdk.NewTags(
+			TagStakeDenom, coin.Denom,
+			TagStakeAmount, coin.Amount.String(),
+		))
+	}
+
+	return resTags
+}
+
+// Settles the stakes of a game which has a winner or ended in a draw
+func (k Keeper) finishGame(ctx sdk.Context, game *Game) sdk.Tags {
+	var resTags sdk.Tags
+	switch game.Winner {
+	case WinnerDraw:
+		resTags = sdk.NewTags(TagOutcome, OutcomeDraw)
+	case WinnerCancelled:
+		resTags = sdk.NewTags(TagOutcome, OutcomeCancelled)
+	default:
+		resTags = sdk.NewTags(TagOutcome, OutcomeWin, TagWinner, game.Player(game.Winner).String())
+	}
+
+	k.clearDeadline(ctx, game)
+	k.deletePlayGrants(ctx, game.Id)
+	k.refundGasAllowance(ctx, AllowanceGame, game.Id)
+
+	// Only games between two players who agreed to play are cancelled, never tournament or league games
+	if game.Winner == WinnerCancelled {
+		k.refundEscrow(ctx, game)
+		if game.MatchId != 0 {
+			k.cancelMatch(ctx, game)
+		}
+
+		return resTags
+	}
+
+	if game.Winner == WinnerDraw {
+		k.refundStakes(ctx, game)
+	} else if !game.Pot().IsZero() {
+		resTags = resTags.AppendTags(k.distributeReward(ctx, game))
+	}
+
+	if game.TournamentId != 0 {
+		resTags = resTags.AppendTags(k.tournamentGameFinished(ctx, game))
+	}
+
+	if game.LeagueId != 0 {
+		resTags = resTags.AppendTags(k.leagueGameFinished(ctx, game))
+	}
+
+	if game.MatchId != 0 {
+		resTags = resTags.AppendTags(k.matchGameFinished(ctx, game))
+	}
+
+	return resTags
+}
+
+// Pays the pot to the winner after taking the rake
+func (k Keeper) distributeReward(ctx sdk.Context, game *Game) sdk.Tags {
+	return k.payout(ctx, game.Player(game.Winner), game.Pot())
+}
+
+func (k Keeper) payout(ctx sdk.Context, winner sdk.AccAddress, gross sdk.Coins) sdk.Tags {
+	p := k.GetParams(ctx)
+	rake, net := p.Rake(gross)
 
-	if err := acc.SetCoins(newCoins); err != nil {
+	k.addCoins(ctx, winner, net)
+
+	// The chain has no distribution module, fees collected would never be paid out
+	if !rake.IsZero() {
+		k.addCoins(ctx, TreasuryAddress, rake)
+	}
+
+	return sdk.NewTags(
+		TagPayoutGross, gross.String(),
+		TagPayoutRake, rake.String(),
+		TagPayoutNet, net.String(),
+	)
+}
+
+// Gives back what was taken of a cancelled game, the opponent's stake is only taken once they accept
+func (k Keeper) refundEscrow(ctx sdk.Context, game *Game) {
+	if !game.Amount1.IsZero() {
+		k.addCoins(ctx, game.Player1, game.Amount1)
+	}
+
+	if !game.AwaitingAccept && !game.Amount2.IsZero() {
+		k.addCoins(ctx, game.Player2, game.Amount2)
+	}
+}
+
+// Gives both players their stakes back, no rake is taken from a draw
+func (k Keeper) refundStakes(ctx sdk.Context, game *Game) {
+	if !game.Amount1.IsZero() {
+		k.addCoins(ctx, game.Player1, game.Amount1)
+	}
+
+	if !game.Amount2.IsZero() {
+		k.addCoins(ctx, game.Player2, game.Amount2)
+	}
+}
+
+func (k Keeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) {
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = k.accountKeeper.NewAccountWithAddress(ctx, addr)
+	}
+
+	if err := acc.SetCoins(acc.GetCoins().Add(amount)); err != nil {
+		panic(err)
+	}
+
+	k.accountKeeper.SetAccount(ctx, acc)
+}
+
+func (k Keeper) subtractCoins(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("No account %s", addr))
+	}
+
+	coins := acc.GetCoins()
+	if !coins.IsAllGTE(amount) {
+		return sdk.ErrInsufficientCoins(fmt.Sprintf("%s has not enough tokens", addr))
+	}
+
+	if err := acc.SetCoins(coins.Sub(amount)); err != nil {
 		panic(err)
 	}
 
 	k.accountKeeper.SetAccount(ctx, acc)
+
+	return nil
 }
 
 func emptyFields() map[string]uint {
@@ -228,40 +576,50 @@ func isFieldTaken(fields map[string]uint, field string) bool {
 	return player != 0
 }
 
-func checkWinner(game *Game) {
-	fields := game.Fields
+// WinnerOf is the result of a board under the rules Play applies: the player with a line, a draw once
+// the board is full or WinnerNone while the game goes on
+func WinnerOf(fields map[string]uint) uint {
+	game := &Game{Fields: fields}
+	checkWinner(game)
+	if game.Winner == WinnerNone && totalMoves(fields) == len(fields) {
+		return WinnerDraw
+	}
 
-	if fields["0"] != 0 {
-		if fields["0"] == fields["1"] && fields["1"] == fields["2"] {
-			game.Winner = fields["0"]
-			return
-		}
+	return game.Winner
+}
 
-		if fields["0"] == fields["4"] && fields["0"] == fields["8"] {
-			game.Winner = fields["0"]
-			return
-		}
+// The rows, columns and diagonals of the board, every variant is won with one of them. They come from
+// the solver, so games are judged the same on chain and off it.
+var winningLines = boardLines()
 
-		if fields["0"] == fields["3"] && fields["0"] == fields["6"] {
-			game.Winner = fields["0"]
-			return
+func boardLines() [][3]string {
+	var result [][3]string
+	for _, line := range solver.Lines(3, 3) {
+		var fields [3]string
+		for i, cell := range line {
+			fields[i] = strconv.Itoa(cell)
 		}
+		result = append(result, fields)
 	}
 
-	if fields["8"] != 0 {
-		if fields["8"] == fields["7"] && fields["8"] == fields["6"] {
-			game.Winner = fields["8"]
-			return
-		}
+	return result
+}
 
-		if fields["8"] == fields["5"] && fields["8"] == fields["2"] {
-			game.Winner = fields["8"]
-			return
+func hasLine(fields map[string]uint, player uint) bool {
+	for _, line := range winningLines {
+		if fields[line[0]] == player && fields[line[1]] == player && fields[line[2]] == player {
+			return true
 		}
 	}
 
-	if fields["2"] != 0 && (fields["2"] == fields["4"] && fields["4"] == fields["6"]) {
-		game.Winner = fields["2"]
-		return
+	return false
+}
+
+func checkWinner(game *Game) {
+	for _, player := range []uint{1, 2} {
+		if hasLine(game.Fields, player) {
+			game.Winner = player
+			return
+		}
 	}
 }
@@ -0,0 +1,321 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	"tic_tac_toe/solver"
+)
+
+type testInput struct {
+	cdc *codec.Codec
+	ctx sdk.Context
+	ak  auth.AccountKeeper
+	k   Keeper
+}
+
+// A keeper on an in-memory store with the default params, governance is left out
+func createTestInput(t *testing.T) testInput {
+	db := dbm.NewMemDB()
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	keyAcc := sdk.NewKVStoreKey("acc")
+	keyParams := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+	key := sdk.NewKVStoreKey("tictactoe")
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	k := NewKeeper(cdc, key, ak, gov.Keeper{}, pk.Subspace(DefaultParamspace))
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain", Height: 1}, false, log.NewNopLogger())
+	ak.SetParams(ctx, auth.DefaultParams())
+	k.SetParams(ctx, DefaultParams())
+
+	return testInput{cdc: cdc, ctx: ctx, ak: ak, k: k}
+}
+
+func testAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+// Creates an account holding coins like "100abc,50xyz"
+func (input testInput) fund(t *testing.T, name, coins string) sdk.AccAddress {
+	addr := testAddress(name)
+	acc := input.ak.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(mustParseCoins(t, coins)))
+	input.ak.SetAccount(input.ctx, acc)
+
+	return addr
+}
+
+func (input testInput) balance(addr sdk.AccAddress) sdk.Coins {
+	acc := input.ak.GetAccount(input.ctx, addr)
+	if acc == nil {
+		return sdk.Coins{}
+	}
+
+	return acc.GetCoins()
+}
+
+func mustParseCoins(t *testing.T, coins string) sdk.Coins {
+	parsed, err := sdk.ParseCoins(coins)
+	require.NoError(t, err)
+
+	return parsed
+}
+
+func TestInviteAcceptCancel(t *testing.T) {
+	tests := []struct {
+		name string
+		// Runs after alice invited bob staking 10abc against 20abc
+		act      func(input testInput, game *Game, alice, bob sdk.AccAddress) sdk.Result
+		ok       bool
+		alice    string
+		bob      string
+		winner   uint
+		awaiting bool
+	}{
+		{
+			name:     "invite takes only the stake of the inviter",
+			act:      func(testInput, *Game, sdk.AccAddress, sdk.AccAddress) sdk.Result { return sdk.Result{} },
+			ok:       true,
+			alice:    "90abc",
+			bob:      "100abc",
+			awaiting: true,
+		},
+		{
+			name: "accept takes the stake of the opponent",
+			act: func(input testInput, game *Game, alice, bob sdk.AccAddress) sdk.Result {
+				return input.k.AcceptGame(input.ctx, game.Id, bob)
+			},
+			ok:    true,
+			alice: "90abc",
+			bob:   "80abc",
+		},
+		{
+			name: "only the opponent accepts",
+			act: func(input testInput, game *Game, alice, bob sdk.AccAddress) sdk.Result {
+				return input.k.AcceptGame(input.ctx, game.Id, alice)
+			},
+			alice:    "90abc",
+			bob:      "100abc",
+			awaiting: true,
+		},
+		{
+			name: "inviter withdraws",
+			act: func(input testInput, game *Game, alice, bob sdk.AccAddress) sdk.Result {
+				return input.k.CancelGame(input.ctx, game.Id, alice)
+			},
+			ok:       true,
+			alice:    "100abc",
+			bob:      "100abc",
+			winner:   WinnerCancelled,
+			awaiting: true,
+		},
+		{
+			name: "opponent declines",
+			act: func(input testInput, game *Game, alice, bob sdk.AccAddress) sdk.Result {
+				return input.k.CancelGame(input.ctx, game.Id, bob)
+			},
+			ok:       true,
+			alice:    "100abc",
+			bob:      "100abc",
+			winner:   WinnerCancelled,
+			awaiting: true,
+		},
+		{
+			name: "accepted game can not be cancelled",
+			act: func(input testInput, game *Game, alice, bob sdk.AccAddress) sdk.Result {
+				input.k.AcceptGame(input.ctx, game.Id, bob)
+				return input.k.CancelGame(input.ctx, game.Id, alice)
+			},
+			alice: "90abc",
+			bob:   "80abc",
+		},
+		{
+			name: "no move before the invite is accepted",
+			act: func(input testInput, game *Game, alice, bob sdk.AccAddress) sdk.Result {
+				return input.k.Play(input.ctx, game.Id, alice, 4)
+			},
+			alice:    "90abc",
+			bob:      "100abc",
+			awaiting: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			alice := input.fund(t, "alice", "100abc")
+			bob := input.fund(t, "bob", "100abc")
+
+			game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic,
+				mustParseCoins(t, "10abc"), mustParseCoins(t, "20abc"), 5)
+			require.True(t, res.IsOK(), res.Log)
+
+			res = tc.act(input, game, alice, bob)
+			require.Equal(t, tc.ok, res.IsOK(), res.Log)
+
+			game = input.k.getGame(input.ctx, game.Id)
+			require.Equal(t, mustParseCoins(t, tc.alice), input.balance(alice))
+			require.Equal(t, mustParseCoins(t, tc.bob), input.balance(bob))
+			require.Equal(t, tc.winner, game.Winner)
+			require.Equal(t, tc.awaiting, game.AwaitingAccept)
+		})
+	}
+}
+
+func TestAcceptWithoutFunds(t *testing.T) {
+	input := createTestInput(t)
+	alice := input.fund(t, "alice", "100abc")
+	bob := input.fund(t, "bob", "5abc")
+
+	game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic,
+		mustParseCoins(t, "10abc"), mustParseCoins(t, "10abc"), 0)
+	require.True(t, res.IsOK(), res.Log)
+
+	require.False(t, input.k.AcceptGame(input.ctx, game.Id, bob).IsOK())
+	require.True(t, input.k.getGame(input.ctx, game.Id).AwaitingAccept)
+	require.Equal(t, mustParseCoins(t, "5abc"), input.balance(bob))
+}
+
+func TestMatchInvite(t *testing.T) {
+	input := createTestInput(t)
+	alice := input.fund(t, "alice", "100abc")
+	bob := input.fund(t, "bob", "100abc")
+
+	match, res := input.k.StartMatch(input.ctx, alice, bob, VariantClassic,
+		mustParseCoins(t, "10abc"), mustParseCoins(t, "30abc"), 0, 3, nil)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, mustParseCoins(t, "90abc"), input.balance(alice))
+	require.Equal(t, mustParseCoins(t, "100abc"), input.balance(bob))
+
+	// The stake of the series is taken when the first game is accepted
+	require.True(t, input.k.AcceptGame(input.ctx, match.Games[0], bob).IsOK())
+	require.Equal(t, mustParseCoins(t, "70abc"), input.balance(bob))
+
+	declined, res := input.k.StartMatch(input.ctx, alice, bob, VariantClassic,
+		mustParseCoins(t, "10abc"), mustParseCoins(t, "30abc"), 0, 3, nil)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.CancelGame(input.ctx, declined.Games[0], bob).IsOK())
+	require.Equal(t, mustParseCoins(t, "90abc"), input.balance(alice))
+	require.Equal(t, mustParseCoins(t, "70abc"), input.balance(bob))
+	require.Equal(t, WinnerCancelled, input.k.getMatch(input.ctx, declined.Id).Winner)
+}
+
+func TestSolverJudgesLikeTheChain(t *testing.T) {
+	input := createTestInput(t)
+	alice := input.fund(t, "alice", "100abc")
+	bob := input.fund(t, "bob", "100abc")
+
+	game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic, sdk.Coins{}, sdk.Coins{}, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.AcceptGame(input.ctx, game.Id, bob).IsOK())
+
+	// Alice wins on the anti-diagonal
+	moves := []int{2, 0, 4, 1, 6}
+	for i, field := range moves {
+		player := alice
+		if i%2 == 1 {
+			player = bob
+		}
+		require.True(t, input.k.Play(input.ctx, game.Id, player, uint(field)).IsOK())
+	}
+	require.Equal(t, uint(1), input.k.getGame(input.ctx, game.Id).Winner)
+
+	annotations, err := solver.Analyze(3, 3, 1, moves)
+	require.NoError(t, err)
+	require.Len(t, annotations, len(moves))
+	require.Equal(t, solver.Win, annotations[len(moves)-1].Played.Outcome)
+	require.Equal(t, len(solver.Lines(3, 3)), len(winningLines))
+}
+
+func TestValidateStakes(t *testing.T) {
+	tests := []struct {
+		name     string
+		allowed  []string
+		maxStake string
+		amount1  string
+		amount2  string
+		ok       bool
+	}{
+		{"any denom", nil, "", "10abc,5xyz", "20abc", true},
+		{"allowed denoms", []string{"abc", "xyz"}, "", "10abc,5xyz", "20abc", true},
+		{"denom of player 1 not allowed", []string{"abc"}, "", "10abc,5xyz", "20abc", false},
+		{"denom of player 2 not allowed", []string{"abc"}, "", "10abc", "5xyz", false},
+		{"within the max stake", nil, "5xyz", "10abc,5xyz", "20abc,5xyz", true},
+		{"above the max stake of a denom", nil, "5xyz", "1000abc,5xyz", "6xyz", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			p := DefaultParams()
+			p.AllowedDenoms = tc.allowed
+			p.MaxStake = mustParseCoins(t, tc.maxStake)
+			input.k.SetParams(input.ctx, p)
+
+			err := input.k.validateStakes(input.ctx, VariantClassic, mustParseCoins(t, tc.amount1), mustParseCoins(t, tc.amount2))
+			require.Equal(t, tc.ok, err == nil, "%v", err)
+		})
+	}
+}
+
+func TestMultiDenomPayout(t *testing.T) {
+	tests := []struct {
+		name  string
+		moves []uint
+		alice string
+		bob   string
+	}{
+		{"winner takes every denom", []uint{0, 3, 1, 4, 2}, "120abc,50xyz", "80abc,50xyz"},
+		{"draw gives every denom back", []uint{0, 1, 2, 4, 3, 5, 7, 6, 8}, "100abc,50xyz", "100abc,50xyz"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			alice := input.fund(t, "alice", "100abc,50xyz")
+			bob := input.fund(t, "bob", "100abc,50xyz")
+
+			game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic,
+				mustParseCoins(t, "10abc,5xyz"), mustParseCoins(t, "20abc"), 0)
+			require.True(t, res.IsOK(), res.Log)
+			require.True(t, input.k.AcceptGame(input.ctx, game.Id, bob).IsOK())
+			require.Equal(t, mustParseCoins(t, "90abc,45xyz"), input.balance(alice))
+			require.Equal(t, mustParseCoins(t, "80abc,50xyz"), input.balance(bob))
+
+			for i, field := range tc.moves {
+				player := alice
+				if i%2 == 1 {
+					player = bob
+				}
+				require.True(t, input.k.Play(input.ctx, game.Id, player, field).IsOK())
+			}
+
+			require.Equal(t, mustParseCoins(t, tc.alice), input.balance(alice))
+			require.Equal(t, mustParseCoins(t, tc.bob), input.balance(bob))
+		})
+	}
+}
@@ -0,0 +1,751 @@
+package tic_tac_toe
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	FormatSwiss      = "swiss"
+	FormatRoundRobin = "round_robin"
+
+	SeasonRegistration = "registration"
+	SeasonRunning      = "running"
+	SeasonFinished     = "finished"
+	SeasonCancelled    = "cancelled"
+)
+
+// League is a group of players meeting every season. Members joining during a season play from the next one.
+type League struct {
+	Id        uint           `json:"id"`
+	Organiser sdk.AccAddress `json:"organiser"`
+	Format    string         `json:"format"`
+	Variant   string         `json:"variant"`
+	// Share of the sponsor pool for each place, first place first
+	PrizeShares []sdk.Dec `json:"prize_shares"`
+	MaxPlayers  uint      `json:"max_players"`
+	MoveTimeout int64     `json:"move_timeout"`
+	// Rounds of a swiss season, round robin seasons have as many rounds as it takes for everybody to meet
+	SwissRounds uint `json:"swiss_rounds"`
+	// Blocks between the start of two rounds, a round never starts before the previous one is over
+	RoundInterval int64            `json:"round_interval"`
+	Members       []sdk.AccAddress `json:"members"`
+	// Number of the current season, starting at 1
+	Season uint `json:"season"`
+}
+
+func (l League) isMember(addr sdk.AccAddress) bool {
+	for _, member := range l.Members {
+		if member.Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Outcome of one game from the view of a player, a bye has no opponent
+type LeagueResult struct {
+	Opponent sdk.AccAddress `json:"opponent"`
+	Score    sdk.Dec        `json:"score"`
+}
+
+type LeaguePlayer struct {
+	Address sdk.AccAddress `json:"address"`
+	Score   sdk.Dec        `json:"score"`
+	// Sum of the scores of all opponents
+	Buchholz sdk.Dec `json:"buchholz"`
+	// Sum of the scores of beaten opponents and half the scores of opponents drawn against
+	SonnebornBerger sdk.Dec `json:"sonneborn_berger"`
+	// Games the player moved first in
+	FirstMoves uint `json:"first_moves"`
+	// Whether the player moved first in the last game
+	LastMovedFirst bool           `json:"last_moved_first"`
+	Byes           uint           `json:"byes"`
+	Results        []LeagueResult `json:"results"`
+}
+
+func (p LeaguePlayer) hasPlayed(addr sdk.AccAddress) bool {
+	for _, result := range p.Results {
+		if result.Opponent.Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Sponsorship struct {
+	Sponsor sdk.AccAddress `json:"sponsor"`
+	Amount  sdk.Coins      `json:"amount"`
+}
+
+type Season struct {
+	LeagueId    uint          `json:"league_id"`
+	Number      uint          `json:"number"`
+	Status      string        `json:"status"`
+	SponsorPool sdk.Coins     `json:"sponsor_pool"`
+	Sponsors    []Sponsorship `json:"sponsors"`
+	// In seeding order
+	Players     []LeaguePlayer    `json:"players"`
+	TotalRounds uint              `json:"total_rounds"`
+	Rounds      []TournamentRound `json:"rounds"`
+	// Height the last round started at
+	RoundStarted int64 `json:"round_started"`
+	// Height the next round starts at, 0 while the current round is being played
+	NextRound int64 `json:"next_round"`
+	// Ranking by score, Buchholz and Sonneborn-Berger, first place first
+	Standings []sdk.AccAddress `json:"standings"`
+}
+
+func (s Season) playerIndex(addr sdk.AccAddress) int {
+	for i, player := range s.Players {
+		if player.Address.Equals(addr) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isKnownLeagueFormat(format string) bool {
+	return format == FormatSwiss || format == FormatRoundRobin
+}
+
+func leagueKey(id uint) []byte {
+	return []byte(fmt.Sprintf("league:%d", id))
+}
+
+func seasonKey(leagueID, number uint) []byte {
+	return []byte(fmt.Sprintf("season:%d:%d", leagueID, number))
+}
+
+// Leagues waiting for their next round are indexed by height like game deadlines
+func leagueRoundKey(height int64, leagueID uint) []byte {
+	return []byte(fmt.Sprintf("league_round:%020d:%d", height, leagueID))
+}
+
+func (k Keeper) nextLeagueId(ctx sdk.Context) uint {
+	store := ctx.KVStore(k.key)
+
+	var id int
+	idBytes := store.Get([]byte("league_id"))
+	if idBytes != nil {
+		var err error
+		id, err = strconv.Atoi(string(idBytes))
+		if err != nil {
+			panic(fmt.Sprintf("Invalid league id: %v", idBytes))
+		}
+	}
+
+	// Ids start at 1 so 0 can mean no league
+	id++
+	store.Set([]byte("league_id"), []byte(strconv.Itoa(id)))
+
+	return uint(id)
+}
+
+func (k Keeper) storeLeague(ctx sdk.Context, league *League) {
+	store := ctx.KVStore(k.key)
+	store.Set(leagueKey(league.Id), k.cdc.MustMarshalJSON(league))
+}
+
+func (k Keeper) getLeague(ctx sdk.Context, id uint) *League {
+	store := ctx.KVStore(k.key)
+	value := store.Get(leagueKey(id))
+	if value == nil {
+		return nil
+	}
+
+	league := new(League)
+	if err := k.cdc.UnmarshalJSON(value, league); err != nil {
+		panic(fmt.Sprintf("Invalid league stored: %s", err))
+	}
+
+	return league
+}
+
+func (k Keeper) storeSeason(ctx sdk.Context, season *Season) {
+	store := ctx.KVStore(k.key)
+	store.Set(seasonKey(season.LeagueId, season.Number), k.cdc.MustMarshalJSON(season))
+}
+
+func (k Keeper) getSeason(ctx sdk.Context, leagueID, number uint) *Season {
+	store := ctx.KVStore(k.key)
+	value := store.Get(seasonKey(leagueID, number))
+	if value == nil {
+		return nil
+	}
+
+	season := new(Season)
+	if err := k.cdc.UnmarshalJSON(value, season); err != nil {
+		panic(fmt.Sprintf("Invalid season stored: %s", err))
+	}
+
+	return season
+}
+
+func (k Keeper) CreateLeague(ctx sdk.Context, organiser sdk.AccAddress, format, variant string, prizeShares []sdk.Dec,
+	maxPlayers, swissRounds uint, roundInterval, moveTimeout int64) (*League, sdk.Result) {
+
+	if !k.GetParams(ctx).IsVariantEnabled(variant) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Variant %s is not enabled", variant)).Result()
+	}
+
+	league := &League{
+		Id:            k.nextLeagueId(ctx),
+		Organiser:     organiser,
+		Format:        format,
+		Variant:       variant,
+		PrizeShares:   prizeShares,
+		MaxPlayers:    maxPlayers,
+		MoveTimeout:   moveTimeout,
+		SwissRounds:   swissRounds,
+		RoundInterval: roundInterval,
+		Season:        1,
+	}
+
+	k.storeLeague(ctx, league)
+	k.storeSeason(ctx, newSeason(league))
+
+	return league, sdk.Result{}
+}
+
+func newSeason(league *League) *Season {
+	return &Season{
+		LeagueId:    league.Id,
+		Number:      league.Season,
+		Status:      SeasonRegistration,
+		SponsorPool: sdk.Coins{},
+	}
+}
+
+func (k Keeper) JoinLeague(ctx sdk.Context, leagueID uint, player sdk.AccAddress) sdk.Result {
+	league := k.getLeague(ctx, leagueID)
+	if league == nil {
+		return sdk.ErrUnknownRequest("No such league").Result()
+	}
+
+	if league.isMember(player) {
+		return sdk.ErrUnknownRequest("Already a member").Result()
+	}
+
+	if uint(len(league.Members)) >= league.MaxPlayers {
+		return sdk.ErrUnknownRequest("League is full").Result()
+	}
+
+	league.Members = append(league.Members, player)
+	k.storeLeague(ctx, league)
+
+	return sdk.Result{}
+}
+
+// Sponsor adds funds to the prize pool of the current season
+func (k Keeper) Sponsor(ctx sdk.Context, leagueID uint, sponsor sdk.AccAddress, amount sdk.Coins) sdk.Result {
+	league := k.getLeague(ctx, leagueID)
+	if league == nil {
+		return sdk.ErrUnknownRequest("No such league").Result()
+	}
+
+	season := k.getSeason(ctx, league.Id, league.Season)
+	if season.Status != SeasonRegistration && season.Status != SeasonRunning {
+		return sdk.ErrUnknownRequest("Season is over").Result()
+	}
+
+	if err := k.subtractCoins(ctx, sponsor, amount); err != nil {
+		return err.Result()
+	}
+
+	season.SponsorPool = season.SponsorPool.Add(amount)
+	season.Sponsors = append(season.Sponsors, Sponsorship{Sponsor: sponsor, Amount: amount})
+	k.storeSeason(ctx, season)
+
+	return sdk.Result{}
+}
+
+func (k Keeper) StartSeason(ctx sdk.Context, leagueID uint, organiser sdk.AccAddress) sdk.Result {
+	league := k.getLeague(ctx, leagueID)
+	if league == nil {
+		return sdk.ErrUnknownRequest("No such league").Result()
+	}
+
+	if !league.Organiser.Equals(organiser) {
+		return sdk.ErrUnauthorized("Only the organiser can start a season").Result()
+	}
+
+	season := k.getSeason(ctx, league.Id, league.Season)
+	if season.Status != SeasonRegistration {
+		return sdk.ErrUnknownRequest("Season already started").Result()
+	}
+
+	if len(league.Members) < 2 {
+		return sdk.ErrUnknownRequest("League needs at least 2 members").Result()
+	}
+
+	for _, member := range league.Members {
+		season.Players = append(season.Players, LeaguePlayer{
+			Address:         member,
+			Score:           sdk.ZeroDec(),
+			Buchholz:        sdk.ZeroDec(),
+			SonnebornBerger: sdk.ZeroDec(),
+		})
+	}
+
+	seedSeason(season)
+	season.Status = SeasonRunning
+	season.TotalRounds = seasonRounds(league, len(season.Players))
+	season.Standings = rankSeason(season)
+
+	resTags := k.startLeagueRound(ctx, league, season)
+	k.storeLeague(ctx, league)
+	k.storeSeason(ctx, season)
+
+	return sdk.Result{Tags: resTags}
+}
+
+// A round robin needs one round less than there are players, or one round per player if
+// somebody has to sit out every round. Swiss seasons never go beyond that so opponents don't repeat.
+func seasonRounds(league *League, players int) uint {
+	rounds := uint(players - 1)
+	if players%2 == 1 {
+		rounds = uint(players)
+	}
+
+	if league.Format == FormatSwiss && league.SwissRounds < rounds {
+		return league.SwissRounds
+	}
+
+	return rounds
+}
+
+func seedSeason(season *Season) {
+	seed := func(addr sdk.AccAddress) []byte {
+		return tmhash.Sum(append([]byte(fmt.Sprintf("%d:%d:", season.LeagueId, season.Number)), addr...))
+	}
+
+	sort.SliceStable(season.Players, func(i, j int) bool {
+		return bytes.Compare(seed(season.Players[i].Address), seed(season.Players[j].Address)) < 0
+	})
+}
+
+// Recomputes the tie-breaks and orders the players by score, Buchholz and Sonneborn-Berger.
+// Players who are still level keep their seeding order.
+func rankSeason(season *Season) []sdk.AccAddress {
+	scores := make(map[string]sdk.Dec)
+	for _, player := range season.Players {
+		scores[player.Address.String()] = player.Score
+	}
+
+	for i := range season.Players {
+		player := &season.Players[i]
+		player.Buchholz = sdk.ZeroDec()
+		player.SonnebornBerger = sdk.ZeroDec()
+
+		for _, result := range player.Results {
+			if result.Opponent.Empty() {
+				continue
+			}
+
+			opponentScore := scores[result.Opponent.String()]
+			player.Buchholz = player.Buchholz.Add(opponentScore)
+			player.SonnebornBerger = player.SonnebornBerger.Add(opponentScore.Mul(result.Score))
+		}
+	}
+
+	ranked := make([]LeaguePlayer, len(season.Players))
+	copy(ranked, season.Players)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if !a.Score.Equal(b.Score) {
+			return a.Score.GT(b.Score)
+		}
+
+		if !a.Buchholz.Equal(b.Buchholz) {
+			return a.Buchholz.GT(b.Buchholz)
+		}
+
+		return a.SonnebornBerger.GT(b.SonnebornBerger)
+	})
+
+	standings := make([]sdk.AccAddress, len(ranked))
+	for i, player := range ranked {
+		standings[i] = player.Address
+	}
+
+	return standings
+}
+
+// Pairs of player indexes, the first one moves first
+type pairing [2]int
+
+// Circle method: the first player stays in place and everybody else rotates by one seat each round.
+// With an odd number of players the empty seat is the bye.
+func roundRobinPairings(players, round int) (pairings []pairing, bye int) {
+	seats := make([]int, 0, players+1)
+	for i := 0; i < players; i++ {
+		seats = append(seats, i)
+	}
+
+	if players%2 == 1 {
+		seats = append(seats, -1)
+	}
+
+	n := len(seats)
+	rotated := []int{seats[0]}
+	for i := 0; i < n-1; i++ {
+		rotated = append(rotated, seats[1+(i+round)%(n-1)])
+	}
+
+	bye = -1
+	for i := 0; i < n/2; i++ {
+		a, b := rotated[i], rotated[n-1-i]
+		if a == -1 {
+			bye = b
+			continue
+		}
+
+		if b == -1 {
+			bye = a
+			continue
+		}
+
+		// Sides alternate so nobody moves first much more often than anybody else
+		if (i == 0 && round%2 == 1) || (i > 0 && i%2 == 1) {
+			a, b = b, a
+		}
+
+		pairings = append(pairings, pairing{a, b})
+	}
+
+	return pairings, bye
+}
+
+// Steps the search for a round without rematches may take, past them the round is paired greedily so a
+// round of a big league costs bounded gas
+const swissSearchSteps = 10000
+
+// Players are paired top down with the highest ranked opponent that still lets everybody below get
+// an opponent they have not met yet. The lowest ranked player who had no bye yet sits out an odd round,
+// the next one up if that leaves no round without rematches. Only when no such round is found within
+// the search steps the players are paired greedily and rematches are played.
+func swissPairings(season *Season) (pairings []pairing, bye int) {
+	var order []int
+	for _, addr := range season.Standings {
+		order = append(order, season.playerIndex(addr))
+	}
+
+	if len(order)%2 == 0 {
+		steps := swissSearchSteps
+		if pairings, ok := pairWithoutRematches(season, order, make([]bool, len(order)), &steps); ok {
+			return pairings, -1
+		}
+
+		return greedyPairings(season, order), -1
+	}
+
+	var candidates []int
+	for i := len(order) - 1; i >= 0; i-- {
+		if season.Players[order[i]].Byes == 0 {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = []int{len(order) - 1}
+	}
+
+	steps := swissSearchSteps
+	for _, at := range candidates {
+		rest := append(order[:at:at], order[at+1:]...)
+		if pairings, ok := pairWithoutRematches(season, rest, make([]bool, len(rest)), &steps); ok {
+			return pairings, order[at]
+		}
+	}
+
+	at := candidates[0]
+	return greedyPairings(season, append(order[:at:at], order[at+1:]...)), order[at]
+}
+
+// Backtracks over the opponents of the highest ranked unpaired player, false if every player can not
+// get a new opponent or the steps ran out
+func pairWithoutRematches(season *Season, order []int, paired []bool, steps *int) ([]pairing, bool) {
+	i := 0
+	for i < len(order) && paired[i] {
+		i++
+	}
+
+	if i == len(order) {
+		return nil, true
+	}
+
+	paired[i] = true
+	for j := i + 1; j < len(order); j++ {
+		if paired[j] || season.Players[order[i]].hasPlayed(season.Players[order[j]].Address) {
+			continue
+		}
+
+		*steps--
+		if *steps < 0 {
+			break
+		}
+
+		paired[j] = true
+		if rest, ok := pairWithoutRematches(season, order, paired, steps); ok {
+			return append([]pairing{sidesFor(season, order[i], order[j])}, rest...), true
+		}
+		paired[j] = false
+	}
+	paired[i] = false
+
+	return nil, false
+}
+
+// Everybody gets the highest ranked opponent they have not met yet, or the highest ranked one left
+func greedyPairings(season *Season, order []int) (pairings []pairing) {
+	paired := make([]bool, len(order))
+	for i := range order {
+		if paired[i] {
+			continue
+		}
+
+		opponent := -1
+		for j := i + 1; j < len(order); j++ {
+			if paired[j] {
+				continue
+			}
+
+			if opponent == -1 {
+				opponent = j
+			}
+
+			if !season.Players[order[i]].hasPlayed(season.Players[order[j]].Address) {
+				opponent = j
+				break
+			}
+		}
+
+		paired[i], paired[opponent] = true, true
+		pairings = append(pairings, sidesFor(season, order[i], order[opponent]))
+	}
+
+	return pairings
+}
+
+// The player who moved first less often moves first, then the one who moved second last time
+func sidesFor(season *Season, a, b int) pairing {
+	playerA, playerB := season.Players[a], season.Players[b]
+
+	if playerA.FirstMoves != playerB.FirstMoves {
+		if playerA.FirstMoves < playerB.FirstMoves {
+			return pairing{a, b}
+		}
+
+		return pairing{b, a}
+	}
+
+	if playerA.LastMovedFirst && !playerB.LastMovedFirst {
+		return pairing{b, a}
+	}
+
+	return pairing{a, b}
+}
+
+func (k Keeper) startLeagueRound(ctx sdk.Context, league *League, season *Season) sdk.Tags {
+	if uint(len(season.Rounds)) >= season.TotalRounds {
+		return k.finishSeason(ctx, league, season)
+	}
+
+	var pairings []pairing
+	var bye int
+	if league.Format == FormatRoundRobin {
+		pairings, bye = roundRobinPairings(len(season.Players), len(season.Rounds))
+	} else {
+		pairings, bye = swissPairings(season)
+	}
+
+	round := TournamentRound{}
+
+	// A swiss bye is worth a win, in a round robin everybody sits out once so it is worth nothing
+	if bye >= 0 {
+		player := &season.Players[bye]
+		player.Byes++
+
+		score := sdk.ZeroDec()
+		if league.Format == FormatSwiss {
+			score = sdk.OneDec()
+		}
+
+		player.Score = player.Score.Add(score)
+		player.Results = append(player.Results, LeagueResult{Score: score})
+		round.Byes = append(round.Byes, player.Address)
+	}
+
+	// Every game of the round is tagged, the tags of a block keep all of them
+	var gameTags sdk.Tags
+	for _, pair := range pairings {
+		player1, player2 := &season.Players[pair[0]], &season.Players[pair[1]]
+
+		game, res := k.StartGame(ctx, player1.Address, player2.Address, league.Variant, sdk.Coins{}, sdk.Coins{}, league.MoveTimeout)
+		if game == nil {
+			ctx.Logger().With("module", "x/tictactoe").Info(
+				fmt.Sprintf("season %d of league %d cancelled: %s", season.Number, league.Id, res.Log))
+			return k.cancelSeason(ctx, league, season)
+		}
+
+		game.LeagueId = league.Id
+		k.storeGame(ctx, game)
+
+		player1.FirstMoves++
+		player1.LastMovedFirst = true
+		player2.LastMovedFirst = false
+
+		round.Games = append(round.Games, game.Id)
+		gameTags = gameTags.AppendTags(turnTags(game))
+	}
+
+	season.Rounds = append(season.Rounds, round)
+	season.RoundStarted = ctx.BlockHeight()
+	season.NextRound = 0
+
+	return sdk.NewTags(
+		TagLeagueId, strconv.Itoa(int(league.Id)),
+		TagLeagueSeason, strconv.Itoa(int(season.Number)),
+		TagLeagueRound, strconv.Itoa(len(season.Rounds)),
+	).AppendTags(gameTags)
+}
+
+// Called when a league game has a result. The game itself is stored by the caller.
+func (k Keeper) leagueGameFinished(ctx sdk.Context, game *Game) sdk.Tags {
+	league := k.getLeague(ctx, game.LeagueId)
+	if league == nil {
+		return nil
+	}
+
+	season := k.getSeason(ctx, league.Id, league.Season)
+	if season.Status != SeasonRunning {
+		return nil
+	}
+
+	score1, score2 := sdk.ZeroDec(), sdk.ZeroDec()
+	switch game.Winner {
+	case 1:
+		score1 = sdk.OneDec()
+	case 2:
+		score2 = sdk.OneDec()
+	default:
+		score1 = sdk.NewDecWithPrec(5, 1)
+		score2 = sdk.NewDecWithPrec(5, 1)
+	}
+
+	player1 := &season.Players[season.playerIndex(game.Player1)]
+	player1.Score = player1.Score.Add(score1)
+	player1.Results = append(player1.Results, LeagueResult{Opponent: game.Player2, Score: score1})
+
+	player2 := &season.Players[season.playerIndex(game.Player2)]
+	player2.Score = player2.Score.Add(score2)
+	player2.Results = append(player2.Results, LeagueResult{Opponent: game.Player1, Score: score2})
+
+	season.Standings = rankSeason(season)
+
+	round := season.Rounds[len(season.Rounds)-1]
+	for _, id := range round.Games {
+		if id == game.Id {
+			continue
+		}
+
+		if other := k.getGame(ctx, id); other != nil && other.Winner == WinnerNone {
+			k.storeSeason(ctx, season)
+			return nil
+		}
+	}
+
+	// The next round is left to the EndBlocker so rounds keep to the schedule
+	season.NextRound = season.RoundStarted + league.RoundInterval
+	if season.NextRound <= ctx.BlockHeight() {
+		season.NextRound = ctx.BlockHeight() + 1
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(leagueRoundKey(season.NextRound, league.Id), []byte(strconv.Itoa(int(league.Id))))
+	k.storeSeason(ctx, season)
+
+	return nil
+}
+
+// Starts the rounds which are due and finishes seasons after their last round
+func (k Keeper) processLeagueRounds(ctx sdk.Context) sdk.Tags {
+	resTags := sdk.NewTags()
+
+	store := ctx.KVStore(k.key)
+	iterator := store.Iterator([]byte("league_round:"), leagueRoundKey(ctx.BlockHeight()+1, 0))
+
+	var keys [][]byte
+	var ids []uint
+	for ; iterator.Valid(); iterator.Next() {
+		id, err := strconv.Atoi(string(iterator.Value()))
+		if err != nil {
+			panic(fmt.Sprintf("Invalid league id: %v", iterator.Value()))
+		}
+
+		keys = append(keys, iterator.Key())
+		ids = append(ids, uint(id))
+	}
+	iterator.Close()
+
+	for i, leagueID := range ids {
+		store.Delete(keys[i])
+
+		league := k.getLeague(ctx, leagueID)
+		season := k.getSeason(ctx, league.Id, league.Season)
+		if season.Status != SeasonRunning {
+			continue
+		}
+
+		resTags = resTags.AppendTags(k.startLeagueRound(ctx, league, season))
+		k.storeLeague(ctx, league)
+		k.storeSeason(ctx, season)
+	}
+
+	return resTags
+}
+
+// Pays out the sponsor pool and opens registration for the next season
+func (k Keeper) finishSeason(ctx sdk.Context, league *League, season *Season) sdk.Tags {
+	season.Standings = rankSeason(season)
+	season.Status = SeasonFinished
+
+	k.payPrizes(ctx, season.SponsorPool, league.PrizeShares, season.Standings)
+
+	league.Season++
+	k.storeSeason(ctx, newSeason(league))
+
+	return sdk.NewTags(
+		TagLeagueId, strconv.Itoa(int(league.Id)),
+		TagLeagueSeason, strconv.Itoa(int(season.Number)),
+		TagLeagueWinner, season.Standings[0].String(),
+	)
+}
+
+// Gives the sponsors their money back and opens registration for the next season
+func (k Keeper) cancelSeason(ctx sdk.Context, league *League, season *Season) sdk.Tags {
+	season.Status = SeasonCancelled
+
+	for _, sponsorship := range season.Sponsors {
+		k.addCoins(ctx, sponsorship.Sponsor, sponsorship.Amount)
+	}
+
+	season.SponsorPool = sdk.Coins{}
+
+	league.Season++
+	k.storeSeason(ctx, newSeason(league))
+
+	return sdk.NewTags(
+		TagLeagueId, strconv.Itoa(int(league.Id)),
+		TagLeagueSeason, strconv.Itoa(int(season.Number)),
+		TagLeagueStatus, SeasonCancelled,
+	)
+}
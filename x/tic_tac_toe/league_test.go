@@ -0,0 +1,103 @@
+package tic_tac_toe
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// A season ranked in the order of the names, played holds pairs like "a-b"
+func testSeason(names []string, played []string, byes []string) *Season {
+	season := &Season{}
+	for _, name := range names {
+		season.Players = append(season.Players, LeaguePlayer{Address: testAddress(name), Score: sdk.ZeroDec()})
+		season.Standings = append(season.Standings, testAddress(name))
+	}
+
+	for _, pair := range played {
+		names := strings.Split(pair, "-")
+		a, b := season.playerIndex(testAddress(names[0])), season.playerIndex(testAddress(names[1]))
+		season.Players[a].Results = append(season.Players[a].Results, LeagueResult{Opponent: season.Players[b].Address})
+		season.Players[b].Results = append(season.Players[b].Results, LeagueResult{Opponent: season.Players[a].Address})
+	}
+
+	for _, name := range byes {
+		season.Players[season.playerIndex(testAddress(name))].Byes++
+	}
+
+	return season
+}
+
+func TestSwissPairings(t *testing.T) {
+	tests := []struct {
+		name   string
+		names  []string
+		played []string
+		byes   []string
+		pairs  []string
+		bye    string
+	}{
+		{
+			name:  "first round",
+			names: []string{"a", "b", "c", "d"},
+			pairs: []string{"a-b", "c-d"},
+		},
+		{
+			name:   "backtracks instead of a rematch below",
+			names:  []string{"a", "b", "c", "d"},
+			played: []string{"c-d"},
+			pairs:  []string{"a-c", "b-d"},
+		},
+		{
+			name:   "backtracks over several levels",
+			names:  []string{"a", "b", "c", "d", "e", "f"},
+			played: []string{"a-b", "c-d", "e-f", "c-e", "d-f"},
+			pairs:  []string{"a-c", "b-f", "d-e"},
+		},
+		{
+			name:   "bye moves up to avoid a rematch",
+			names:  []string{"a", "b", "c"},
+			played: []string{"a-b"},
+			pairs:  []string{"a-c"},
+			bye:    "b",
+		},
+		{
+			name:  "bye goes to the lowest player without one",
+			names: []string{"a", "b", "c"},
+			byes:  []string{"c"},
+			pairs: []string{"a-c"},
+			bye:   "b",
+		},
+		{
+			name:   "rematch only when unavoidable",
+			names:  []string{"a", "b"},
+			played: []string{"a-b"},
+			pairs:  []string{"a-b"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			season := testSeason(tc.names, tc.played, tc.byes)
+			pairings, bye := swissPairings(season)
+
+			var pairs []string
+			for _, p := range pairings {
+				sides := []string{tc.names[p[0]], tc.names[p[1]]}
+				sort.Strings(sides)
+				pairs = append(pairs, strings.Join(sides, "-"))
+			}
+			sort.Strings(pairs)
+			require.Equal(t, tc.pairs, pairs)
+
+			if tc.bye == "" {
+				require.Equal(t, -1, bye)
+			} else {
+				require.Equal(t, tc.bye, tc.names[bye])
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package tic_tac_toe
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Match is a series of games between the same two players settled with a single stake
+type Match struct {
+	Id      uint           `json:"id"`
+	Variant string         `json:"variant"`
+	Amount1 sdk.Coins      `json:"amount_1"`
+	Amount2 sdk.Coins      `json:"amount_2"`
+	Player1 sdk.AccAddress `json:"player_1"`
+	Player2 sdk.AccAddress `json:"player_2"`
+	// Number of games the series is played over. If it ends level up to as many games more are played,
+	// a series still level after those is drawn and both players get their stakes back.
+	Length      uint  `json:"length"`
+	MoveTimeout int64 `json:"move_timeout"`
+	// Ids of the games of the series, the last one is being played unless the match is over
+	Games []uint `json:"games"`
+	// A win is worth a point and a draw half a point
+	Score1 sdk.Dec `json:"score_1"`
+	Score2 sdk.Dec `json:"score_2"`
+	Winner uint    `json:"winner"`
+}
+
+func (match Match) Pot() sdk.Coins {
+	return match.Amount1.Add(match.Amount2)
+}
+
+func (match Match) Player(number uint) sdk.AccAddress {
+	if number == 1 {
+		return match.Player1
+	}
+
+	return match.Player2
+}
+
+// A player clinched the series once the lead is bigger than the points still to be played for
+func (match Match) leader() uint {
+	var remaining int64
+	if played := uint(len(match.Games)); played < match.Length {
+		remaining = int64(match.Length - played)
+	}
+
+	lead := match.Score1.Sub(match.Score2)
+	switch {
+	case lead.GT(sdk.NewDec(remaining)):
+		return 1
+	case lead.Neg().GT(sdk.NewDec(remaining)):
+		return 2
+	default:
+		return WinnerNone
+	}
+}
+
+func (match Match) maxGames() uint {
+	return 2 * match.Length
+}
+
+func matchKey(id uint) []byte {
+	return []byte(fmt.Sprintf("match:%d", id))
+}
+
+func (k Keeper) nextMatchId(ctx sdk.Context) uint {
+	store := ctx.KVStore(k.key)
+
+	var id int
+	idBytes := store.Get([]byte("match_id"))
+	if idBytes != nil {
+		var err error
+		id, err = strconv.Atoi(string(idBytes))
+		if err != nil {
+			panic(fmt.Sprintf("Invalid match id: %v", idBytes))
+		}
+	}
+
+	// Ids start at 1 so 0 can mean no match
+	id++
+	store.Set([]byte("match_id"), []byte(strconv.Itoa(id)))
+
+	return uint(id)
+}
+
+func (k Keeper) storeMatch(ctx sdk.Context, match *Match) {
+	store := ctx.KVStore(k.key)
+	store.Set(matchKey(match.Id), k.cdc.MustMarshalJSON(match))
+}
+
+func (k Keeper) getMatch(ctx sdk.Context, id uint) *Match {
+	store := ctx.KVStore(k.key)
+	value := store.Get(matchKey(id))
+	if value == nil {
+		return nil
+	}
+
+	match := new(Match)
+	if err := k.cdc.UnmarshalJSON(value, match); err != nil {
+		panic(fmt.Sprintf("Invalid match stored: %s", err))
+	}
+
+	return match
+}
+
+// StartMatch escrows the stake of player 1 for the whole series and invites player 2 to its first game,
+// the stake of player 2 is escrowed when they accept it
+func (k Keeper) StartMatch(ctx sdk.Context, player1, player2 sdk.AccAddress, variant string, amount1, amount2 sdk.Coins,
+	moveTimeout int64, length uint, tossCommitment []byte) (*Match, sdk.Result) {
+	if err := k.validateStakes(ctx, variant, amount1, amount2); err != nil {
+		return nil, err.Result()
+	}
+
+	if !amount1.IsZero() {
+		if err := k.subtractCoins(ctx, player1, amount1); err != nil {
+			return nil, err.Result()
+		}
+	}
+
+	match := &Match{
+		Id:          k.nextMatchId(ctx),
+		Variant:     variant,
+		Amount1:     amount1,
+		Amount2:     amount2,
+		Player1:     player1,
+		Player2:     player2,
+		Length:      length,
+		MoveTimeout: moveTimeout,
+		Score1:      sdk.ZeroDec(),
+		Score2:      sdk.ZeroDec(),
+	}
+
+	game := k.startMatchGame(ctx, match)
+	k.awaitAccept(ctx, game)
+	if len(tossCommitment) > 0 {
+		k.startToss(ctx, game, tossCommitment)
+	}
+
+	k.storeMatch(ctx, match)
+
+	return match, sdk.Result{Tags: turnTags(game)}
+}
+
+// Who moves first alternates, the first game is started by player 1 or decided by a toss.
+// The variant is not checked again so a series started before a variant got disabled can still be finished.
+func (k Keeper) startMatchGame(ctx sdk.Context, match *Match) *Game {
+	player1, player2 := match.Player1, match.Player2
+	if len(match.Games) > 0 {
+		last := k.getGame(ctx, match.Games[len(match.Games)-1])
+		player1, player2 = last.Player(otherPlayer(last.FirstPlayer)), last.Player(last.FirstPlayer)
+	}
+
+	game := k.createGame(ctx, player1, player2, match.Variant, sdk.Coins{}, sdk.Coins{}, match.MoveTimeout)
+	game.MatchId = match.Id
+	k.storeGame(ctx, game)
+
+	match.Games = append(match.Games, game.Id)
+
+	return game
+}
+
+// Called when a game of the series has a result. The game itself is stored by the caller.
+func (k Keeper) matchGameFinished(ctx sdk.Context, game *Game) sdk.Tags {
+	match := k.getMatch(ctx, game.MatchId)
+	if match == nil || match.Winner != WinnerNone {
+		return nil
+	}
+
+	switch {
+	case game.Winner == WinnerDraw:
+		half := sdk.NewDecWithPrec(5, 1)
+		match.Score1 = match.Score1.Add(half)
+		match.Score2 = match.Score2.Add(half)
+	case game.Player(game.Winner).Equals(match.Player1):
+		match.Score1 = match.Score1.Add(sdk.OneDec())
+	default:
+		match.Score2 = match.Score2.Add(sdk.OneDec())
+	}
+
+	resTags := sdk.NewTags(TagMatchId, strconv.Itoa(int(match.Id)))
+
+	match.Winner = match.leader()
+	if match.Winner == WinnerNone && uint(len(match.Games)) >= match.maxGames() {
+		match.Winner = WinnerDraw
+	}
+
+	switch match.Winner {
+	case WinnerNone:
+		next := k.startMatchGame(ctx, match)
+		resTags = resTags.AppendTag(TagMatchGame, strconv.Itoa(int(next.Id))).AppendTags(turnTags(next))
+	case WinnerDraw:
+		// No rake is taken from a drawn series, like from a drawn game
+		if !match.Amount1.IsZero() {
+			k.addCoins(ctx, match.Player1, match.Amount1)
+		}
+
+		if !match.Amount2.IsZero() {
+			k.addCoins(ctx, match.Player2, match.Amount2)
+		}
+	default:
+		resTags = resTags.AppendTag(TagMatchWinner, match.Player(match.Winner).String())
+
+		if !match.Pot().IsZero() {
+			resTags = resTags.AppendTags(k.payout(ctx, match.Player(match.Winner), match.Pot()))
+		}
+	}
+
+	k.storeMatch(ctx, match)
+
+	return resTags
+}
+
+// Ends a series whose game got cancelled, the stake of player 2 is only escrowed once they accepted
+func (k Keeper) cancelMatch(ctx sdk.Context, game *Game) {
+	match := k.getMatch(ctx, game.MatchId)
+	if match == nil || match.Winner != WinnerNone {
+		return
+	}
+
+	if !match.Amount1.IsZero() {
+		k.addCoins(ctx, match.Player1, match.Amount1)
+	}
+
+	if !game.AwaitingAccept && !match.Amount2.IsZero() {
+		k.addCoins(ctx, match.Player2, match.Amount2)
+	}
+
+	match.Winner = WinnerCancelled
+	k.storeMatch(ctx, match)
+}
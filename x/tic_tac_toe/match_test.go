@@ -0,0 +1,71 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testSeat(game *Game, addr sdk.AccAddress) uint {
+	if game.Player1.Equals(addr) {
+		return 1
+	}
+
+	return 2
+}
+
+func TestMatchSeries(t *testing.T) {
+	tests := []struct {
+		name   string
+		length uint
+		// Results of the games in order: "alice", "bob" or "draw"
+		results []string
+		winner  uint
+		alice   string
+		bob     string
+	}{
+		{"clinched early", 3, []string{"alice", "alice"}, 1, "110abc", "90abc"},
+		{"still open", 3, []string{"alice", "bob"}, WinnerNone, "90abc", "90abc"},
+		{"decided in an extra game", 3, []string{"draw", "draw", "draw", "bob"}, 2, "90abc", "110abc"},
+		{"level after the extra games", 1, []string{"draw", "draw"}, WinnerDraw, "100abc", "100abc"},
+		{"level after a long series", 3, []string{"alice", "bob", "draw", "draw", "draw", "draw"}, WinnerDraw, "100abc", "100abc"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			alice := input.fund(t, "alice", "100abc")
+			bob := input.fund(t, "bob", "100abc")
+			stake := mustParseCoins(t, "10abc")
+
+			match, res := input.k.StartMatch(input.ctx, alice, bob, VariantClassic, stake, stake, 0, tc.length, nil)
+			require.True(t, res.IsOK(), res.Log)
+			require.True(t, input.k.AcceptGame(input.ctx, match.Games[0], bob).IsOK())
+
+			for i, result := range tc.results {
+				match = input.k.getMatch(input.ctx, match.Id)
+				require.Equal(t, WinnerNone, match.Winner, "game %d", i)
+
+				game := input.k.getGame(input.ctx, match.Games[len(match.Games)-1])
+				switch result {
+				case "draw":
+					game.Winner = WinnerDraw
+				case "alice":
+					game.Winner = testSeat(game, alice)
+				default:
+					game.Winner = testSeat(game, bob)
+				}
+
+				input.k.finishGame(input.ctx, game)
+				input.k.storeGame(input.ctx, game)
+			}
+
+			match = input.k.getMatch(input.ctx, match.Id)
+			require.Equal(t, tc.winner, match.Winner)
+			require.True(t, uint(len(match.Games)) <= match.maxGames())
+			require.Equal(t, mustParseCoins(t, tc.alice), input.balance(alice))
+			require.Equal(t, mustParseCoins(t, tc.bob), input.balance(bob))
+		})
+	}
+}
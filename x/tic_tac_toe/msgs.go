@@ -2,20 +2,41 @@ package tic_tac_toe
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 type MsgStartGame struct {
 	Opponent sdkTypes.AccAddress `json:"opponent"`
 	Inviter  sdkTypes.AccAddress `json:"inviter"`
-	Amount sdkTypes.Coin `json:"amount"`
+	Variant  string              `json:"variant"`
+	// Stakes can differ when one player gives odds
+	InviterAmount  sdkTypes.Coins `json:"inviter_amount"`
+	OpponentAmount sdkTypes.Coins `json:"opponent_amount"`
+	// Blocks per move, 0 for no time limit
+	MoveTimeout int64 `json:"move_timeout"`
+	// Games of a best of N series played for the stakes, 0 or 1 for a single game
+	SeriesLength uint `json:"series_length"`
+	// Inviter's commitment for a toss deciding who moves first, empty if the inviter moves first
+	TossCommitment []byte `json:"toss_commitment"`
+	// Difficulty of the house when the opponent is the house, 0 otherwise
+	HouseLevel uint `json:"house_level"`
 }
 
-func NewMsgStartGame(inviter, opponent sdkTypes.AccAddress, amount sdkTypes.Coin) MsgStartGame {
+func NewMsgStartGame(inviter, opponent sdkTypes.AccAddress, variant string, inviterAmount, opponentAmount sdkTypes.Coins,
+	moveTimeout int64, seriesLength uint, tossCommitment []byte, houseLevel uint) MsgStartGame {
 	return MsgStartGame{
-		Inviter:  inviter,
-		Opponent: opponent,
-		Amount: amount,
+		Inviter:        inviter,
+		Opponent:       opponent,
+		Variant:        variant,
+		InviterAmount:  inviterAmount,
+		OpponentAmount: opponentAmount,
+		MoveTimeout:    moveTimeout,
+		SeriesLength:   seriesLength,
+		TossCommitment: tossCommitment,
+		HouseLevel:     houseLevel,
 	}
 }
 
@@ -36,6 +57,38 @@ func (msg MsgStartGame) ValidateBasic() sdkTypes.Error {
 		return sdkTypes.ErrInvalidAddress("Opponent is empty")
 	}
 
+	if !isKnownVariant(msg.Variant) {
+		return sdkTypes.ErrUnknownRequest("Unknown variant")
+	}
+
+	if !msg.InviterAmount.IsValid() {
+		return sdkTypes.ErrInvalidCoins(fmt.Sprintf("Invalid inviter stake %s", msg.InviterAmount))
+	}
+
+	if !msg.OpponentAmount.IsValid() {
+		return sdkTypes.ErrInvalidCoins(fmt.Sprintf("Invalid opponent stake %s", msg.OpponentAmount))
+	}
+
+	if msg.MoveTimeout < 0 {
+		return sdkTypes.ErrUnknownRequest("Move timeout can not be negative")
+	}
+
+	if msg.Opponent.Equals(HouseAddress) {
+		if !isKnownHouseLevel(msg.HouseLevel) {
+			return sdkTypes.ErrUnknownRequest(fmt.Sprintf("House level has to be %d to %d", HouseEasy, HousePerfect))
+		}
+
+		if msg.Variant != VariantClassic || msg.SeriesLength > 1 || len(msg.TossCommitment) > 0 {
+			return sdkTypes.ErrUnknownRequest("The house only plays single classic games and lets you move first")
+		}
+	} else if msg.HouseLevel != 0 {
+		return sdkTypes.ErrUnknownRequest("House level needs the house as opponent")
+	}
+
+	if len(msg.TossCommitment) > 0 {
+		return validateTossCommitment(msg.TossCommitment)
+	}
+
 	return nil
 }
 
@@ -55,7 +108,8 @@ func (msg MsgStartGame) GetSigners() []sdkTypes.AccAddress {
 //
 
 type MsgPlay struct {
-	GameId uint                `json:game_id"`
+	GameId uint `json:"game_id"`
+	// Player or a key the player granted to play the game for them
 	Player sdkTypes.AccAddress `json:"player"`
 	Field  uint                `json:"field"`
 }
@@ -100,3 +154,1282 @@ func (msg MsgPlay) GetSignBytes() []byte {
 func (msg MsgPlay) GetSigners() []sdkTypes.AccAddress {
 	return []sdkTypes.AccAddress{msg.Player}
 }
+
+//
+
+// MsgAcceptGame is signed by the opponent of an invite, their stake is only taken and the clock only
+// starts once they accept
+type MsgAcceptGame struct {
+	GameId   uint                `json:"game_id"`
+	Opponent sdkTypes.AccAddress `json:"opponent"`
+}
+
+func NewMsgAcceptGame(gameId uint, opponent sdkTypes.AccAddress) MsgAcceptGame {
+	return MsgAcceptGame{
+		GameId:   gameId,
+		Opponent: opponent,
+	}
+}
+
+func (msg MsgAcceptGame) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgAcceptGame) Type() string {
+	return "acceptgame"
+}
+
+func (msg MsgAcceptGame) ValidateBasic() sdkTypes.Error {
+	if msg.Opponent.Empty() {
+		return sdkTypes.ErrInvalidAddress("Opponent is empty")
+	}
+
+	return nil
+}
+
+func (msg MsgAcceptGame) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgAcceptGame) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Opponent}
+}
+
+//
+
+// MsgCancelGame withdraws an invite when sent by the inviter and declines it when sent by the opponent
+type MsgCancelGame struct {
+	GameId uint                `json:"game_id"`
+	Player sdkTypes.AccAddress `json:"player"`
+}
+
+func NewMsgCancelGame(gameId uint, player sdkTypes.AccAddress) MsgCancelGame {
+	return MsgCancelGame{
+		GameId: gameId,
+		Player: player,
+	}
+}
+
+func (msg MsgCancelGame) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgCancelGame) Type() string {
+	return "cancelgame"
+}
+
+func (msg MsgCancelGame) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	return nil
+}
+
+func (msg MsgCancelGame) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgCancelGame) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgSubmitProposal struct {
+	Title          string              `json:"title"`
+	Description    string              `json:"description"`
+	Action         ProposalAction      `json:"action"`
+	Proposer       sdkTypes.AccAddress `json:"proposer"`
+	InitialDeposit sdkTypes.Coins      `json:"initial_deposit"`
+}
+
+func NewMsgSubmitProposal(title, description string, action ProposalAction, proposer sdkTypes.AccAddress, initialDeposit sdkTypes.Coins) MsgSubmitProposal {
+	return MsgSubmitProposal{
+		Title:          title,
+		Description:    description,
+		Action:         action,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+	}
+}
+
+func (msg MsgSubmitProposal) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgSubmitProposal) Type() string {
+	return "submitproposal"
+}
+
+func (msg MsgSubmitProposal) ValidateBasic() sdkTypes.Error {
+	if msg.Proposer.Empty() {
+		return sdkTypes.ErrInvalidAddress("Proposer is empty")
+	}
+
+	if len(strings.TrimSpace(msg.Title)) == 0 {
+		return sdkTypes.ErrUnknownRequest("Title is empty")
+	}
+
+	if len(strings.TrimSpace(msg.Description)) == 0 {
+		return sdkTypes.ErrUnknownRequest("Description is empty")
+	}
+
+	if msg.Action == nil {
+		return sdkTypes.ErrUnknownRequest("Action is empty")
+	}
+
+	if err := msg.Action.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !msg.InitialDeposit.IsValid() {
+		return sdkTypes.ErrInvalidCoins(msg.InitialDeposit.String())
+	}
+
+	return nil
+}
+
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgSubmitProposal) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Proposer}
+}
+
+//
+
+type MsgCreateTournament struct {
+	Organiser   sdkTypes.AccAddress `json:"organiser"`
+	Format      string              `json:"format"`
+	Variant     string              `json:"variant"`
+	EntryFee    sdkTypes.Coins      `json:"entry_fee"`
+	PrizeShares []sdkTypes.Dec      `json:"prize_shares"`
+	MaxEntrants uint                `json:"max_entrants"`
+	MoveTimeout int64               `json:"move_timeout"`
+}
+
+func NewMsgCreateTournament(organiser sdkTypes.AccAddress, format, variant string, entryFee sdkTypes.Coins,
+	prizeShares []sdkTypes.Dec, maxEntrants uint, moveTimeout int64) MsgCreateTournament {
+	return MsgCreateTournament{
+		Organiser:   organiser,
+		Format:      format,
+		Variant:     variant,
+		EntryFee:    entryFee,
+		PrizeShares: prizeShares,
+		MaxEntrants: maxEntrants,
+		MoveTimeout: moveTimeout,
+	}
+}
+
+func (msg MsgCreateTournament) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgCreateTournament) Type() string {
+	return "createtournament"
+}
+
+func (msg MsgCreateTournament) ValidateBasic() sdkTypes.Error {
+	if msg.Organiser.Empty() {
+		return sdkTypes.ErrInvalidAddress("Organiser is empty")
+	}
+
+	if !isKnownFormat(msg.Format) {
+		return sdkTypes.ErrUnknownRequest("Unknown tournament format")
+	}
+
+	if !isKnownVariant(msg.Variant) {
+		return sdkTypes.ErrUnknownRequest("Unknown variant")
+	}
+
+	if !msg.EntryFee.IsValid() {
+		return sdkTypes.ErrInvalidCoins(fmt.Sprintf("Invalid entry fee %s", msg.EntryFee))
+	}
+
+	if msg.MaxEntrants < 2 {
+		return sdkTypes.ErrUnknownRequest("Tournament needs at least 2 entrants")
+	}
+
+	// Without a time limit one absent player would stall the whole bracket
+	if msg.MoveTimeout <= 0 {
+		return sdkTypes.ErrUnknownRequest("Tournament games need a move timeout")
+	}
+
+	return validatePrizeShares(msg.PrizeShares, msg.MaxEntrants)
+}
+
+func (msg MsgCreateTournament) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgCreateTournament) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Organiser}
+}
+
+//
+
+type MsgRegister struct {
+	TournamentId uint                `json:"tournament_id"`
+	Player       sdkTypes.AccAddress `json:"player"`
+}
+
+func NewMsgRegister(tournamentId uint, player sdkTypes.AccAddress) MsgRegister {
+	return MsgRegister{
+		TournamentId: tournamentId,
+		Player:       player,
+	}
+}
+
+func (msg MsgRegister) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgRegister) Type() string {
+	return "register"
+}
+
+func (msg MsgRegister) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	return nil
+}
+
+func (msg MsgRegister) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgRegister) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgStartTournament struct {
+	TournamentId uint                `json:"tournament_id"`
+	Organiser    sdkTypes.AccAddress `json:"organiser"`
+}
+
+func NewMsgStartTournament(tournamentId uint, organiser sdkTypes.AccAddress) MsgStartTournament {
+	return MsgStartTournament{
+		TournamentId: tournamentId,
+		Organiser:    organiser,
+	}
+}
+
+func (msg MsgStartTournament) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgStartTournament) Type() string {
+	return "starttournament"
+}
+
+func (msg MsgStartTournament) ValidateBasic() sdkTypes.Error {
+	if msg.Organiser.Empty() {
+		return sdkTypes.ErrInvalidAddress("Organiser is empty")
+	}
+
+	return nil
+}
+
+func (msg MsgStartTournament) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgStartTournament) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Organiser}
+}
+
+//
+
+type MsgCancelTournament struct {
+	TournamentId uint                `json:"tournament_id"`
+	Organiser    sdkTypes.AccAddress `json:"organiser"`
+}
+
+func NewMsgCancelTournament(tournamentId uint, organiser sdkTypes.AccAddress) MsgCancelTournament {
+	return MsgCancelTournament{
+		TournamentId: tournamentId,
+		Organiser:    organiser,
+	}
+}
+
+func (msg MsgCancelTournament) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgCancelTournament) Type() string {
+	return "canceltournament"
+}
+
+func (msg MsgCancelTournament) ValidateBasic() sdkTypes.Error {
+	if msg.Organiser.Empty() {
+		return sdkTypes.ErrInvalidAddress("Organiser is empty")
+	}
+
+	return nil
+}
+
+func (msg MsgCancelTournament) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgCancelTournament) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Organiser}
+}
+
+//
+
+type MsgCreateLeague struct {
+	Organiser     sdkTypes.AccAddress `json:"organiser"`
+	Format        string              `json:"format"`
+	Variant       string              `json:"variant"`
+	PrizeShares   []sdkTypes.Dec      `json:"prize_shares"`
+	MaxPlayers    uint                `json:"max_players"`
+	SwissRounds   uint                `json:"swiss_rounds"`
+	RoundInterval int64               `json:"round_interval"`
+	MoveTimeout   int64               `json:"move_timeout"`
+}
+
+func NewMsgCreateLeague(organiser sdkTypes.AccAddress, format, variant string, prizeShares []sdkTypes.Dec,
+	maxPlayers, swissRounds uint, roundInterval, moveTimeout int64) MsgCreateLeague {
+	return MsgCreateLeague{
+		Organiser:     organiser,
+		Format:        format,
+		Variant:       variant,
+		PrizeShares:   prizeShares,
+		MaxPlayers:    maxPlayers,
+		SwissRounds:   swissRounds,
+		RoundInterval: roundInterval,
+		MoveTimeout:   moveTimeout,
+	}
+}
+
+func (msg MsgCreateLeague) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgCreateLeague) Type() string {
+	return "createleague"
+}
+
+func (msg MsgCreateLeague) ValidateBasic() sdkTypes.Error {
+	if msg.Organiser.Empty() {
+		return sdkTypes.ErrInvalidAddress("Organiser is empty")
+	}
+
+	if !isKnownLeagueFormat(msg.Format) {
+		return sdkTypes.ErrUnknownRequest("Unknown league format")
+	}
+
+	if !isKnownVariant(msg.Variant) {
+		return sdkTypes.ErrUnknownRequest("Unknown variant")
+	}
+
+	if msg.MaxPlayers < 2 {
+		return sdkTypes.ErrUnknownRequest("League needs at least 2 players")
+	}
+
+	if msg.Format == FormatSwiss && msg.SwissRounds == 0 {
+		return sdkTypes.ErrUnknownRequest("Swiss league needs at least 1 round")
+	}
+
+	if msg.RoundInterval < 0 {
+		return sdkTypes.ErrUnknownRequest("Round interval can not be negative")
+	}
+
+	if msg.MoveTimeout < 0 {
+		return sdkTypes.ErrUnknownRequest("Move timeout can not be negative")
+	}
+
+	return validatePrizeShares(msg.PrizeShares, msg.MaxPlayers)
+}
+
+func (msg MsgCreateLeague) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgCreateLeague) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Organiser}
+}
+
+//
+
+type MsgJoinLeague struct {
+	LeagueId uint                `json:"league_id"`
+	Player   sdkTypes.AccAddress `json:"player"`
+}
+
+func NewMsgJoinLeague(leagueId uint, player sdkTypes.AccAddress) MsgJoinLeague {
+	return MsgJoinLeague{
+		LeagueId: leagueId,
+		Player:   player,
+	}
+}
+
+func (msg MsgJoinLeague) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgJoinLeague) Type() string {
+	return "joinleague"
+}
+
+func (msg MsgJoinLeague) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	return nil
+}
+
+func (msg MsgJoinLeague) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgJoinLeague) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgSponsorLeague struct {
+	LeagueId uint                `json:"league_id"`
+	Sponsor  sdkTypes.AccAddress `json:"sponsor"`
+	Amount   sdkTypes.Coins      `json:"amount"`
+}
+
+func NewMsgSponsorLeague(leagueId uint, sponsor sdkTypes.AccAddress, amount sdkTypes.Coins) MsgSponsorLeague {
+	return MsgSponsorLeague{
+		LeagueId: leagueId,
+		Sponsor:  sponsor,
+		Amount:   amount,
+	}
+}
+
+func (msg MsgSponsorLeague) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgSponsorLeague) Type() string {
+	return "sponsorleague"
+}
+
+func (msg MsgSponsorLeague) ValidateBasic() sdkTypes.Error {
+	if msg.Sponsor.Empty() {
+		return sdkTypes.ErrInvalidAddress("Sponsor is empty")
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkTypes.ErrInvalidCoins(fmt.Sprintf("Invalid amount %s", msg.Amount))
+	}
+
+	return nil
+}
+
+func (msg MsgSponsorLeague) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgSponsorLeague) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Sponsor}
+}
+
+//
+
+type MsgStartSeason struct {
+	LeagueId  uint                `json:"league_id"`
+	Organiser sdkTypes.AccAddress `json:"organiser"`
+}
+
+func NewMsgStartSeason(leagueId uint, organiser sdkTypes.AccAddress) MsgStartSeason {
+	return MsgStartSeason{
+		LeagueId:  leagueId,
+		Organiser: organiser,
+	}
+}
+
+func (msg MsgStartSeason) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgStartSeason) Type() string {
+	return "startseason"
+}
+
+func (msg MsgStartSeason) ValidateBasic() sdkTypes.Error {
+	if msg.Organiser.Empty() {
+		return sdkTypes.ErrInvalidAddress("Organiser is empty")
+	}
+
+	return nil
+}
+
+func (msg MsgStartSeason) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgStartSeason) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Organiser}
+}
+
+//
+
+type MsgCommitToss struct {
+	GameId     uint                `json:"game_id"`
+	Player     sdkTypes.AccAddress `json:"player"`
+	Commitment []byte              `json:"commitment"`
+}
+
+func NewMsgCommitToss(gameId uint, player sdkTypes.AccAddress, commitment []byte) MsgCommitToss {
+	return MsgCommitToss{
+		GameId:     gameId,
+		Player:     player,
+		Commitment: commitment,
+	}
+}
+
+func (msg MsgCommitToss) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgCommitToss) Type() string {
+	return "committoss"
+}
+
+func (msg MsgCommitToss) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	return validateTossCommitment(msg.Commitment)
+}
+
+func (msg MsgCommitToss) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgCommitToss) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgRevealToss struct {
+	GameId uint                `json:"game_id"`
+	Player sdkTypes.AccAddress `json:"player"`
+	Secret []byte              `json:"secret"`
+}
+
+func NewMsgRevealToss(gameId uint, player sdkTypes.AccAddress, secret []byte) MsgRevealToss {
+	return MsgRevealToss{
+		GameId: gameId,
+		Player: player,
+		Secret: secret,
+	}
+}
+
+func (msg MsgRevealToss) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgRevealToss) Type() string {
+	return "revealtoss"
+}
+
+func (msg MsgRevealToss) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	if len(msg.Secret) != TossSecretLength {
+		return sdkTypes.ErrUnknownRequest(fmt.Sprintf("Secret has to be %d bytes", TossSecretLength))
+	}
+
+	return nil
+}
+
+func (msg MsgRevealToss) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgRevealToss) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgCommitMove struct {
+	GameId     uint                `json:"game_id"`
+	Player     sdkTypes.AccAddress `json:"player"`
+	Commitment []byte              `json:"commitment"`
+}
+
+func NewMsgCommitMove(gameId uint, player sdkTypes.AccAddress, commitment []byte) MsgCommitMove {
+	return MsgCommitMove{
+		GameId:     gameId,
+		Player:     player,
+		Commitment: commitment,
+	}
+}
+
+func (msg MsgCommitMove) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgCommitMove) Type() string {
+	return "commitmove"
+}
+
+func (msg MsgCommitMove) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	return validateTossCommitment(msg.Commitment)
+}
+
+func (msg MsgCommitMove) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgCommitMove) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgRevealMove struct {
+	GameId uint                `json:"game_id"`
+	Player sdkTypes.AccAddress `json:"player"`
+	Field  uint                `json:"field"`
+	Salt   []byte              `json:"salt"`
+}
+
+func NewMsgRevealMove(gameId uint, player sdkTypes.AccAddress, field uint, salt []byte) MsgRevealMove {
+	return MsgRevealMove{
+		GameId: gameId,
+		Player: player,
+		Field:  field,
+		Salt:   salt,
+	}
+}
+
+func (msg MsgRevealMove) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgRevealMove) Type() string {
+	return "revealmove"
+}
+
+func (msg MsgRevealMove) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	if msg.Field > 8 {
+		return sdkTypes.ErrUnknownRequest("Field has to be from 0 to 8")
+	}
+
+	if len(msg.Salt) < BlindSaltLength {
+		return sdkTypes.ErrUnknownRequest(fmt.Sprintf("Salt has to be at least %d bytes", BlindSaltLength))
+	}
+
+	return nil
+}
+
+func (msg MsgRevealMove) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgRevealMove) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgQuantumMove struct {
+	GameId uint                `json:"game_id"`
+	Player sdkTypes.AccAddress `json:"player"`
+	Field1 uint                `json:"field_1"`
+	Field2 uint                `json:"field_2"`
+}
+
+func NewMsgQuantumMove(gameId uint, player sdkTypes.AccAddress, field1, field2 uint) MsgQuantumMove {
+	return MsgQuantumMove{
+		GameId: gameId,
+		Player: player,
+		Field1: field1,
+		Field2: field2,
+	}
+}
+
+func (msg MsgQuantumMove) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgQuantumMove) Type() string {
+	return "quantummove"
+}
+
+func (msg MsgQuantumMove) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	if msg.Field1 > 8 || msg.Field2 > 8 {
+		return sdkTypes.ErrUnknownRequest("Field has to be from 0 to 8")
+	}
+
+	return nil
+}
+
+func (msg MsgQuantumMove) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgQuantumMove) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgCollapse struct {
+	GameId uint                `json:"game_id"`
+	Player sdkTypes.AccAddress `json:"player"`
+	Field  uint                `json:"field"`
+}
+
+func NewMsgCollapse(gameId uint, player sdkTypes.AccAddress, field uint) MsgCollapse {
+	return MsgCollapse{
+		GameId: gameId,
+		Player: player,
+		Field:  field,
+	}
+}
+
+func (msg MsgCollapse) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgCollapse) Type() string {
+	return "collapse"
+}
+
+func (msg MsgCollapse) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	if msg.Field > 8 {
+		return sdkTypes.ErrUnknownRequest("Field has to be from 0 to 8")
+	}
+
+	return nil
+}
+
+func (msg MsgCollapse) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgCollapse) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgOpenChannel struct {
+	Opponent       sdkTypes.AccAddress `json:"opponent"`
+	Inviter        sdkTypes.AccAddress `json:"inviter"`
+	InviterAmount  sdkTypes.Coins      `json:"inviter_amount"`
+	OpponentAmount sdkTypes.Coins      `json:"opponent_amount"`
+}
+
+func NewMsgOpenChannel(inviter, opponent sdkTypes.AccAddress, inviterAmount, opponentAmount sdkTypes.Coins) MsgOpenChannel {
+	return MsgOpenChannel{
+		Inviter:        inviter,
+		Opponent:       opponent,
+		InviterAmount:  inviterAmount,
+		OpponentAmount: opponentAmount,
+	}
+}
+
+func (msg MsgOpenChannel) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgOpenChannel) Type() string {
+	return "openchannel"
+}
+
+func (msg MsgOpenChannel) ValidateBasic() sdkTypes.Error {
+	if msg.Inviter.Empty() {
+		return sdkTypes.ErrInvalidAddress("Inviter is empty")
+	}
+
+	if msg.Opponent.Empty() {
+		return sdkTypes.ErrInvalidAddress("Opponent is empty")
+	}
+
+	if msg.Inviter.Equals(msg.Opponent) {
+		return sdkTypes.ErrInvalidAddress("Can not open a channel with yourself")
+	}
+
+	if msg.Opponent.Equals(HouseAddress) {
+		return sdkTypes.ErrInvalidAddress("The house does not play in channels")
+	}
+
+	if !msg.InviterAmount.IsValid() {
+		return sdkTypes.ErrInvalidCoins(fmt.Sprintf("Invalid inviter stake %s", msg.InviterAmount))
+	}
+
+	if !msg.OpponentAmount.IsValid() {
+		return sdkTypes.ErrInvalidCoins(fmt.Sprintf("Invalid opponent stake %s", msg.OpponentAmount))
+	}
+
+	return nil
+}
+
+func (msg MsgOpenChannel) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+// Both stakes are escrowed by the open, so the opponent signs it as well
+func (msg MsgOpenChannel) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Inviter, msg.Opponent}
+}
+
+//
+
+// Closes with a state signed by both players, the initial board of nonce 0 needs no signatures
+type MsgCloseChannel struct {
+	GameId     uint                `json:"game_id"`
+	Sender     sdkTypes.AccAddress `json:"sender"`
+	State      ChannelState        `json:"state"`
+	Signature1 []byte              `json:"signature_1"`
+	Signature2 []byte              `json:"signature_2"`
+	// Only needed for a player without a public key on chain
+	PubKey1 crypto.PubKey `json:"pub_key_1"`
+	PubKey2 crypto.PubKey `json:"pub_key_2"`
+}
+
+func NewMsgCloseChannel(gameId uint, sender sdkTypes.AccAddress, state ChannelState, signature1, signature2 []byte,
+	pubKey1, pubKey2 crypto.PubKey) MsgCloseChannel {
+	return MsgCloseChannel{
+		GameId:     gameId,
+		Sender:     sender,
+		State:      state,
+		Signature1: signature1,
+		Signature2: signature2,
+		PubKey1:    pubKey1,
+		PubKey2:    pubKey2,
+	}
+}
+
+func (msg MsgCloseChannel) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgCloseChannel) Type() string {
+	return "closechannel"
+}
+
+func (msg MsgCloseChannel) ValidateBasic() sdkTypes.Error {
+	return validateChannelStateMsg(msg.Sender, msg.GameId, msg.State)
+}
+
+func (msg MsgCloseChannel) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgCloseChannel) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Sender}
+}
+
+//
+
+type MsgChallengeChannel struct {
+	GameId     uint                `json:"game_id"`
+	Sender     sdkTypes.AccAddress `json:"sender"`
+	State      ChannelState        `json:"state"`
+	Signature1 []byte              `json:"signature_1"`
+	Signature2 []byte              `json:"signature_2"`
+	// Only needed for a player without a public key on chain
+	PubKey1 crypto.PubKey `json:"pub_key_1"`
+	PubKey2 crypto.PubKey `json:"pub_key_2"`
+}
+
+func NewMsgChallengeChannel(gameId uint, sender sdkTypes.AccAddress, state ChannelState, signature1, signature2 []byte,
+	pubKey1, pubKey2 crypto.PubKey) MsgChallengeChannel {
+	return MsgChallengeChannel{
+		GameId:     gameId,
+		Sender:     sender,
+		State:      state,
+		Signature1: signature1,
+		Signature2: signature2,
+		PubKey1:    pubKey1,
+		PubKey2:    pubKey2,
+	}
+}
+
+func (msg MsgChallengeChannel) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgChallengeChannel) Type() string {
+	return "challengechannel"
+}
+
+func (msg MsgChallengeChannel) ValidateBasic() sdkTypes.Error {
+	if err := validateChannelStateMsg(msg.Sender, msg.GameId, msg.State); err != nil {
+		return err
+	}
+
+	if len(msg.Signature1) == 0 || len(msg.Signature2) == 0 {
+		return sdkTypes.ErrUnauthorized("State has to be signed by both players")
+	}
+
+	return nil
+}
+
+func (msg MsgChallengeChannel) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgChallengeChannel) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Sender}
+}
+
+func validateChannelStateMsg(sender sdkTypes.AccAddress, gameId uint, state ChannelState) sdkTypes.Error {
+	if sender.Empty() {
+		return sdkTypes.ErrInvalidAddress("Sender is empty")
+	}
+
+	if state.GameId != gameId {
+		return sdkTypes.ErrUnknownRequest("State is for another game")
+	}
+
+	return state.validate()
+}
+
+//
+
+type MsgForceMove struct {
+	GameId uint                `json:"game_id"`
+	Player sdkTypes.AccAddress `json:"player"`
+	Field  uint                `json:"field"`
+}
+
+func NewMsgForceMove(gameId uint, player sdkTypes.AccAddress, field uint) MsgForceMove {
+	return MsgForceMove{
+		GameId: gameId,
+		Player: player,
+		Field:  field,
+	}
+}
+
+func (msg MsgForceMove) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgForceMove) Type() string {
+	return "forcemove"
+}
+
+func (msg MsgForceMove) ValidateBasic() sdkTypes.Error {
+	if msg.Player.Empty() {
+		return sdkTypes.ErrInvalidAddress("Player is empty")
+	}
+
+	if msg.Field > 8 {
+		return sdkTypes.ErrUnknownRequest("Field has to be from 0 to 8")
+	}
+
+	return nil
+}
+
+func (msg MsgForceMove) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgForceMove) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Player}
+}
+
+//
+
+type MsgGrantPlay struct {
+	GameId  uint                `json:"game_id"`
+	Granter sdkTypes.AccAddress `json:"granter"`
+	Grantee sdkTypes.AccAddress `json:"grantee"`
+	// Last height the grant can be used at, 0 for no expiry
+	Expiry int64 `json:"expiry"`
+	// Moves the grantee can play, 0 for no limit
+	MaxMoves uint `json:"max_moves"`
+}
+
+func NewMsgGrantPlay(gameId uint, granter, grantee sdkTypes.AccAddress, expiry int64, maxMoves uint) MsgGrantPlay {
+	return MsgGrantPlay{
+		GameId:   gameId,
+		Granter:  granter,
+		Grantee:  grantee,
+		Expiry:   expiry,
+		MaxMoves: maxMoves,
+	}
+}
+
+func (msg MsgGrantPlay) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgGrantPlay) Type() string {
+	return "grantplay"
+}
+
+func (msg MsgGrantPlay) ValidateBasic() sdkTypes.Error {
+	if msg.Granter.Empty() {
+		return sdkTypes.ErrInvalidAddress("Granter is empty")
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkTypes.ErrInvalidAddress("Grantee is empty")
+	}
+
+	if msg.Granter.Equals(msg.Grantee) {
+		return sdkTypes.ErrInvalidAddress("Can not grant to yourself")
+	}
+
+	if msg.Expiry < 0 {
+		return sdkTypes.ErrUnknownRequest("Expiry can not be negative")
+	}
+
+	return nil
+}
+
+func (msg MsgGrantPlay) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgGrantPlay) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Granter}
+}
+
+//
+
+type MsgRevokePlay struct {
+	GameId  uint                `json:"game_id"`
+	Granter sdkTypes.AccAddress `json:"granter"`
+	Grantee sdkTypes.AccAddress `json:"grantee"`
+}
+
+func NewMsgRevokePlay(gameId uint, granter, grantee sdkTypes.AccAddress) MsgRevokePlay {
+	return MsgRevokePlay{
+		GameId:  gameId,
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+func (msg MsgRevokePlay) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgRevokePlay) Type() string {
+	return "revokeplay"
+}
+
+func (msg MsgRevokePlay) ValidateBasic() sdkTypes.Error {
+	if msg.Granter.Empty() {
+		return sdkTypes.ErrInvalidAddress("Granter is empty")
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkTypes.ErrInvalidAddress("Grantee is empty")
+	}
+
+	return nil
+}
+
+func (msg MsgRevokePlay) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgRevokePlay) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Granter}
+}
+
+//
+
+type MsgFundGasAllowance struct {
+	Sponsor sdkTypes.AccAddress `json:"sponsor"`
+	// Either game or tournament
+	Scope  string         `json:"scope"`
+	Id     uint           `json:"id"`
+	Amount sdkTypes.Coins `json:"amount"`
+}
+
+func NewMsgFundGasAllowance(sponsor sdkTypes.AccAddress, scope string, id uint, amount sdkTypes.Coins) MsgFundGasAllowance {
+	return MsgFundGasAllowance{
+		Sponsor: sponsor,
+		Scope:   scope,
+		Id:      id,
+		Amount:  amount,
+	}
+}
+
+func (msg MsgFundGasAllowance) Route() string {
+	return "tictactoe"
+}
+
+func (msg MsgFundGasAllowance) Type() string {
+	return "fundgasallowance"
+}
+
+func (msg MsgFundGasAllowance) ValidateBasic() sdkTypes.Error {
+	if msg.Sponsor.Empty() {
+		return sdkTypes.ErrInvalidAddress("Sponsor is empty")
+	}
+
+	if !isKnownAllowanceScope(msg.Scope) {
+		return sdkTypes.ErrUnknownRequest(fmt.Sprintf("Scope has to be %s or %s", AllowanceGame, AllowanceTournament))
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkTypes.ErrInvalidCoins(fmt.Sprintf("Invalid amount %s", msg.Amount))
+	}
+
+	return nil
+}
+
+func (msg MsgFundGasAllowance) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgFundGasAllowance) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Sponsor}
+}
@@ -0,0 +1,216 @@
+package tic_tac_toe
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	DefaultParamspace = "tictactoe"
+)
+
+var (
+	KeyMaxStake        = []byte("MaxStake")
+	KeyAllowedDenoms   = []byte("AllowedDenoms")
+	KeyEnabledVariants = []byte("EnabledVariants")
+	KeyRakeRate        = []byte("RakeRate")
+	KeyRakeCap         = []byte("RakeCap")
+	KeyRevealTimeout   = []byte("RevealTimeout")
+	KeyChallengePeriod = []byte("ChallengePeriod")
+
+	KeySponsoredMovesPerBlock = []byte("SponsoredMovesPerBlock")
+	KeyMaxSponsoredFee        = []byte("MaxSponsoredFee")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params are the game rules that can be changed through governance
+type Params struct {
+	// Upper limit of a stake per denom, denoms not listed are not limited
+	MaxStake sdk.Coins `json:"max_stake"`
+	// Denoms which can be staked, any denom can be staked if empty
+	AllowedDenoms   []string  `json:"allowed_denoms"`
+	EnabledVariants []string  `json:"enabled_variants"`
+	// Share of every wagered payout kept in the treasury, from 0 to 1
+	RakeRate sdk.Dec `json:"rake_rate"`
+	// Upper limit of the rake per denom, denoms not listed are not limited
+	RakeCap sdk.Coins `json:"rake_cap"`
+	// Blocks a player has to commit or reveal a secret before forfeiting the game
+	RevealTimeout int64 `json:"reveal_timeout"`
+	// Blocks the other player has to answer a unilateral channel close
+	ChallengePeriod int64 `json:"challenge_period"`
+	// Moves an account can send per block with fees paid by a gas allowance, 0 turns sponsoring off
+	SponsoredMovesPerBlock int64 `json:"sponsored_moves_per_block"`
+	// Most a gas allowance pays toward the fee of one move, the player pays the rest. Fees in denoms not listed
+	// are not sponsored.
+	MaxSponsoredFee sdk.Coins `json:"max_sponsored_fee"`
+}
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyMaxStake, Value: &p.MaxStake},
+		{Key: KeyAllowedDenoms, Value: &p.AllowedDenoms},
+		{Key: KeyEnabledVariants, Value: &p.EnabledVariants},
+		{Key: KeyRakeRate, Value: &p.RakeRate},
+		{Key: KeyRakeCap, Value: &p.RakeCap},
+		{Key: KeyRevealTimeout, Value: &p.RevealTimeout},
+		{Key: KeyChallengePeriod, Value: &p.ChallengePeriod},
+		{Key: KeySponsoredMovesPerBlock, Value: &p.SponsoredMovesPerBlock},
+		{Key: KeyMaxSponsoredFee, Value: &p.MaxSponsoredFee},
+	}
+}
+
+func DefaultParams() Params {
+	return Params{
+		MaxStake:        sdk.Coins{},
+		AllowedDenoms:   []string{},
+		EnabledVariants: []string{VariantClassic},
+		RakeRate:        sdk.ZeroDec(),
+		RakeCap:         sdk.Coins{},
+		RevealTimeout:   100,
+		ChallengePeriod: 100,
+
+		SponsoredMovesPerBlock: 3,
+		MaxSponsoredFee:        sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)},
+	}
+}
+
+func (p Params) Validate() error {
+	if !p.MaxStake.IsValid() {
+		return fmt.Errorf("Invalid max stake: %s", p.MaxStake)
+	}
+
+	for _, denom := range p.AllowedDenoms {
+		if !(sdk.Coins{{Denom: denom, Amount: sdk.OneInt()}}).IsValid() {
+			return fmt.Errorf("Invalid denom: %s", denom)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, variant := range p.EnabledVariants {
+		if !isKnownVariant(variant) {
+			return fmt.Errorf("Unknown variant: %s", variant)
+		}
+
+		if seen[variant] {
+			return fmt.Errorf("Duplicate variant: %s", variant)
+		}
+
+		seen[variant] = true
+	}
+
+	if p.RakeRate.IsNil() || p.RakeRate.IsNegative() || p.RakeRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("Rake rate has to be between 0 and 1, is %s", p.RakeRate)
+	}
+
+	if !p.RakeCap.IsValid() {
+		return fmt.Errorf("Invalid rake cap: %s", p.RakeCap)
+	}
+
+	if p.RevealTimeout <= 0 {
+		return fmt.Errorf("Reveal timeout has to be positive, is %d", p.RevealTimeout)
+	}
+
+	if p.ChallengePeriod <= 0 {
+		return fmt.Errorf("Challenge period has to be positive, is %d", p.ChallengePeriod)
+	}
+
+	if p.SponsoredMovesPerBlock < 0 {
+		return fmt.Errorf("Sponsored moves per block can not be negative, is %d", p.SponsoredMovesPerBlock)
+	}
+
+	if !p.MaxSponsoredFee.IsValid() {
+		return fmt.Errorf("Invalid max sponsored fee: %s", p.MaxSponsoredFee)
+	}
+
+	return nil
+}
+
+func (p Params) IsDenomAllowed(denom string) bool {
+	if len(p.AllowedDenoms) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Splits a payout into the rake and what is left for the player, each denom is raked separately
+func (p Params) Rake(gross sdk.Coins) (rake, net sdk.Coins) {
+	rake = sdk.Coins{}
+
+	for _, coin := range gross {
+		rakeAmount := p.RakeRate.MulInt(coin.Amount).TruncateInt()
+
+		rakeCap := p.RakeCap.AmountOf(coin.Denom)
+		if !rakeCap.IsZero() && rakeAmount.GT(rakeCap) {
+			rakeAmount = rakeCap
+		}
+
+		if rakeAmount.IsPositive() {
+			rake = append(rake, sdk.NewCoin(coin.Denom, rakeAmount))
+		}
+	}
+
+	net = gross.Sub(rake)
+
+	return rake, net
+}
+
+// The part of a move fee a gas allowance pays, each denom is capped separately
+func (p Params) SponsoredFee(fee sdk.Coins) sdk.Coins {
+	sponsored := sdk.Coins{}
+
+	for _, coin := range fee {
+		amount := sdk.MinInt(coin.Amount, p.MaxSponsoredFee.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			sponsored = append(sponsored, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return sponsored
+}
+
+func (p Params) IsVariantEnabled(variant string) bool {
+	for _, enabled := range p.EnabledVariants {
+		if enabled == variant {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Max stake:        %s
+  Allowed denoms:   %v
+  Enabled variants: %v
+  Rake rate:        %s
+  Rake cap:         %s
+  Reveal timeout:   %d
+  Challenge period: %d
+  Sponsored moves:  %d per block
+  Sponsored fee:    at most %s`, p.MaxStake, p.AllowedDenoms, p.EnabledVariants, p.RakeRate, p.RakeCap,
+		p.RevealTimeout, p.ChallengePeriod, p.SponsoredMovesPerBlock, p.MaxSponsoredFee)
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	var p Params
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, p Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
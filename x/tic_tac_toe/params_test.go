@@ -0,0 +1,65 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRake(t *testing.T) {
+	tests := []struct {
+		name  string
+		rate  string
+		cap   string
+		gross string
+		rake  string
+		net   string
+	}{
+		{"no rake", "0", "", "20abc", "", "20abc"},
+		{"five percent", "0.05", "", "200abc", "10abc", "190abc"},
+		{"rounds down", "0.05", "", "30abc", "1abc", "29abc"},
+		{"too small to rake", "0.05", "", "19abc", "", "19abc"},
+		{"capped", "0.1", "5abc", "200abc", "5abc", "195abc"},
+		{"cap of another denom", "0.1", "5xyz", "200abc", "20abc", "180abc"},
+		{"each denom on its own", "0.1", "5abc", "200abc,40xyz", "5abc,4xyz", "195abc,36xyz"},
+		{"everything", "1", "", "20abc", "20abc", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := DefaultParams()
+			p.RakeRate = sdk.MustNewDecFromStr(tc.rate)
+			p.RakeCap = mustParseCoins(t, tc.cap)
+
+			rake, net := p.Rake(mustParseCoins(t, tc.gross))
+			require.Equal(t, tc.rake, rake.String())
+			require.Equal(t, tc.net, net.String())
+		})
+	}
+}
+
+func TestRakeGoesToTreasury(t *testing.T) {
+	input := createTestInput(t)
+	p := DefaultParams()
+	p.RakeRate = sdk.NewDecWithPrec(1, 1)
+	input.k.SetParams(input.ctx, p)
+
+	alice := input.fund(t, "alice", "100abc")
+	bob := input.fund(t, "bob", "100abc")
+	stake := mustParseCoins(t, "10abc")
+	game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic, stake, stake, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.AcceptGame(input.ctx, game.Id, bob).IsOK())
+
+	for i, field := range []uint{0, 3, 1, 4, 2} {
+		player := alice
+		if i%2 == 1 {
+			player = bob
+		}
+		require.True(t, input.k.Play(input.ctx, game.Id, player, field).IsOK())
+	}
+
+	require.Equal(t, mustParseCoins(t, "108abc"), input.balance(alice))
+	require.Equal(t, mustParseCoins(t, "2abc"), input.balance(TreasuryAddress))
+}
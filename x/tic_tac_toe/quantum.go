@@ -0,0 +1,333 @@
+package tic_tac_toe
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// In quantum tic tac toe every move puts a spooky mark into two fields. Marks sharing a field are
+// entangled, and once the entanglement graph has a cycle the player who did not close it decides
+// which field the closing mark collapses into. Everything entangled with it collapses along.
+const VariantQuantum = "quantum"
+
+const notCollapsed = -1
+
+type QuantumMark struct {
+	Fields [2]uint `json:"fields"`
+	// Field the mark collapsed into, -1 while spooky
+	Collapsed int `json:"collapsed"`
+}
+
+func (mark QuantumMark) spooky() bool {
+	return mark.Collapsed == notCollapsed
+}
+
+func (mark QuantumMark) other(field uint) uint {
+	if mark.Fields[0] == field {
+		return mark.Fields[1]
+	}
+
+	return mark.Fields[0]
+}
+
+type QuantumBoard struct {
+	// Mark i was placed by move i+1, odd moves are played by the first player
+	Marks []QuantumMark `json:"marks"`
+	// Number of the move which closed a cycle and has to be collapsed before the next move, 0 if none
+	PendingCollapse uint    `json:"pending_collapse"`
+	Score1          sdk.Dec `json:"score_1"`
+	Score2          sdk.Dec `json:"score_2"`
+}
+
+func newQuantumBoard() *QuantumBoard {
+	return &QuantumBoard{
+		Marks:  []QuantumMark{},
+		Score1: sdk.ZeroDec(),
+		Score2: sdk.ZeroDec(),
+	}
+}
+
+// Number of the move which collapsed into each field, 0 if the field is not classical yet
+func (board QuantumBoard) classical() [9]uint {
+	var fields [9]uint
+	for i, mark := range board.Marks {
+		if !mark.spooky() {
+			fields[mark.Collapsed] = uint(i + 1)
+		}
+	}
+
+	return fields
+}
+
+func (board QuantumBoard) freeFields() []uint {
+	var free []uint
+	for field, move := range board.classical() {
+		if move == 0 {
+			free = append(free, uint(field))
+		}
+	}
+
+	return free
+}
+
+// Whether the two fields are already connected by spooky marks, a mark between them closes a cycle
+func (board QuantumBoard) entangled(from, to uint) bool {
+	seen := map[uint]bool{from: true}
+	queue := []uint{from}
+
+	for len(queue) > 0 {
+		field := queue[0]
+		queue = queue[1:]
+
+		if field == to {
+			return true
+		}
+
+		for _, mark := range board.Marks {
+			if !mark.spooky() || (mark.Fields[0] != field && mark.Fields[1] != field) {
+				continue
+			}
+
+			if next := mark.other(field); !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return false
+}
+
+// Collapses a mark into a field and every mark sharing that field into its other field, and so on
+func (board *QuantumBoard) collapse(move uint, field uint) {
+	type collapse struct {
+		move  uint
+		field uint
+	}
+
+	queue := []collapse{{move, field}}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+
+		mark := &board.Marks[c.move-1]
+		if !mark.spooky() {
+			continue
+		}
+
+		mark.Collapsed = int(c.field)
+
+		for i, other := range board.Marks {
+			if other.spooky() && (other.Fields[0] == c.field || other.Fields[1] == c.field) {
+				queue = append(queue, collapse{uint(i + 1), other.other(c.field)})
+			}
+		}
+	}
+}
+
+func quantumMover(game *Game, move uint) uint {
+	if move%2 == 1 {
+		return game.FirstPlayer
+	}
+
+	return otherPlayer(game.FirstPlayer)
+}
+
+// Scores the classical lines. Every line finished by the same move scores a point, so a mark that
+// completes two lines at once gets two. If both players got a line in the same collapse, the one whose
+// line was finished with the earlier move gets its points and the other one half a point.
+func scoreQuantum(game *Game) {
+	board := game.Quantum
+	classical := board.classical()
+
+	// The latest move of the earliest finished line of each player and how many lines it finished
+	var best, lines [3]uint
+	for _, line := range winningLines {
+		var moves [3]uint
+		for i, fieldStr := range line {
+			field, _ := strconv.Atoi(fieldStr)
+			moves[i] = classical[field]
+		}
+
+		if moves[0] == 0 || moves[1] == 0 || moves[2] == 0 {
+			continue
+		}
+
+		player := quantumMover(game, moves[0])
+		if quantumMover(game, moves[1]) != player || quantumMover(game, moves[2]) != player {
+			continue
+		}
+
+		latest := moves[0]
+		for _, move := range moves[1:] {
+			if move > latest {
+				latest = move
+			}
+		}
+
+		switch {
+		case best[player] == 0 || latest < best[player]:
+			best[player], lines[player] = latest, 1
+		case latest == best[player]:
+			lines[player]++
+		}
+	}
+
+	half := sdk.NewDecWithPrec(5, 1)
+	points1, points2 := sdk.NewDec(int64(lines[1])), sdk.NewDec(int64(lines[2]))
+	switch {
+	case best[1] != 0 && best[2] != 0:
+		if best[1] < best[2] {
+			board.Score1, board.Score2 = points1, half
+		} else {
+			board.Score1, board.Score2 = half, points2
+		}
+	case best[1] != 0:
+		board.Score1 = points1
+	case best[2] != 0:
+		board.Score2 = points2
+	}
+}
+
+// Mirrors the classical marks into the game fields so the board reads like any other game
+func syncQuantumFields(game *Game) {
+	for field, move := range game.Quantum.classical() {
+		if move != 0 {
+			game.Fields[strconv.Itoa(field)] = quantumMover(game, move)
+		}
+	}
+}
+
+func (k Keeper) getQuantumGame(ctx sdk.Context, gameID uint, player sdk.AccAddress) (*Game, sdk.Error) {
+	game := k.getGame(ctx, gameID)
+	if game == nil {
+		return nil, sdk.ErrUnknownRequest("No such game")
+	}
+
+	if game.Quantum == nil {
+		return nil, sdk.ErrUnknownRequest("Not a quantum game")
+	}
+
+	if game.Winner != WinnerNone {
+		return nil, sdk.ErrUnknownRequest("Game already finished")
+	}
+
+	if game.AwaitingAccept {
+		return nil, sdk.ErrUnknownRequest("The invite is not accepted yet")
+	}
+
+	if game.FirstPlayer == 0 {
+		return nil, sdk.ErrUnknownRequest("The toss for the first move is not decided yet")
+	}
+
+	if !game.Player(game.PlayerToMove()).Equals(player) {
+		return nil, sdk.ErrUnknownRequest("Not your turn")
+	}
+
+	return game, nil
+}
+
+// QuantumMove puts a spooky mark into two free fields. When only one field is left the mark is
+// classical and both fields are the same.
+func (k Keeper) QuantumMove(ctx sdk.Context, gameID uint, player sdk.AccAddress, field1, field2 uint) sdk.Result {
+	game, err := k.getQuantumGame(ctx, gameID, player)
+	if err != nil {
+		return err.Result()
+	}
+
+	board := game.Quantum
+	if board.PendingCollapse != 0 {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Move %d has to be collapsed first", board.PendingCollapse)).Result()
+	}
+
+	free := board.freeFields()
+	classical := board.classical()
+	if classical[field1] != 0 || classical[field2] != 0 {
+		return sdk.ErrUnknownRequest("Field is already taken").Result()
+	}
+
+	if (field1 == field2) != (len(free) == 1) {
+		return sdk.ErrUnknownRequest("A mark needs two different fields unless only one is left").Result()
+	}
+
+	board.Marks = append(board.Marks, QuantumMark{Fields: [2]uint{field1, field2}, Collapsed: notCollapsed})
+	move := uint(len(board.Marks))
+
+	resTags := gameTags(game).AppendTag(TagQuantumMove, strconv.Itoa(int(move)))
+
+	switch {
+	case field1 == field2:
+		resTags = resTags.AppendTags(k.collapseQuantum(ctx, game, move, field1))
+	case board.entangledWithout(move, field1, field2):
+		board.PendingCollapse = move
+		resTags = resTags.AppendTag(TagQuantumCycle, strconv.Itoa(int(move)))
+		k.resetDeadline(ctx, game)
+	default:
+		k.resetDeadline(ctx, game)
+	}
+
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: resTags}
+}
+
+// Checks for a cycle ignoring the newest mark itself
+func (board QuantumBoard) entangledWithout(move uint, field1, field2 uint) bool {
+	without := QuantumBoard{Marks: board.Marks[:move-1]}
+	return without.entangled(field1, field2)
+}
+
+// Collapse decides the field of the mark which closed a cycle, it is done by the player who did not close it
+func (k Keeper) Collapse(ctx sdk.Context, gameID uint, player sdk.AccAddress, field uint) sdk.Result {
+	game, err := k.getQuantumGame(ctx, gameID, player)
+	if err != nil {
+		return err.Result()
+	}
+
+	board := game.Quantum
+	if board.PendingCollapse == 0 {
+		return sdk.ErrUnknownRequest("Nothing to collapse").Result()
+	}
+
+	mark := board.Marks[board.PendingCollapse-1]
+	if mark.Fields[0] != field && mark.Fields[1] != field {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Move %d can only collapse into field %d or %d",
+			board.PendingCollapse, mark.Fields[0], mark.Fields[1])).Result()
+	}
+
+	resTags := gameTags(game).AppendTags(k.collapseQuantum(ctx, game, board.PendingCollapse, field))
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: resTags}
+}
+
+// Collapses a mark and ends the game once somebody has a line or no field is left
+func (k Keeper) collapseQuantum(ctx sdk.Context, game *Game, move uint, field uint) sdk.Tags {
+	board := game.Quantum
+	board.collapse(move, field)
+	board.PendingCollapse = 0
+
+	syncQuantumFields(game)
+	scoreQuantum(game)
+
+	resTags := sdk.NewTags(TagQuantumCollapse, strconv.Itoa(int(move)))
+
+	switch {
+	case board.Score1.GT(board.Score2):
+		game.Winner = 1
+	case board.Score2.GT(board.Score1):
+		game.Winner = 2
+	case len(board.freeFields()) == 0:
+		game.Winner = WinnerDraw
+	}
+
+	if game.Winner != WinnerNone {
+		return resTags.AppendTags(k.finishGame(ctx, game))
+	}
+
+	k.resetDeadline(ctx, game)
+
+	return resTags
+}
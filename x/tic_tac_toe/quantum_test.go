@@ -0,0 +1,70 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScoreQuantum(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name string
+		// Field each move collapsed into, odd moves are X played by player 1
+		collapsed []int
+		score1    sdk.Dec
+		score2    sdk.Dec
+	}{
+		{"no line", []int{0, 1, 2, 4}, sdk.ZeroDec(), sdk.ZeroDec()},
+		{"line of player 1", []int{0, 3, 1, 4, 2}, sdk.OneDec(), sdk.ZeroDec()},
+		{"middle column of player 2", []int{0, 1, 2, 4, 3, 7}, sdk.ZeroDec(), sdk.OneDec()},
+		{"earlier line gets the point", []int{0, 3, 1, 4, 2, 5}, sdk.OneDec(), half},
+		{"later line gets half", []int{3, 0, 4, 1, 8, 2, 5}, half, sdk.OneDec()},
+		{"two lines finished by one mark", []int{1, 4, 2, 5, 3, 8, 6, 7, 0}, sdk.NewDec(2), sdk.ZeroDec()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			game := &Game{FirstPlayer: 1, Fields: emptyFields(), Quantum: newQuantumBoard()}
+			for _, field := range tc.collapsed {
+				game.Quantum.Marks = append(game.Quantum.Marks, QuantumMark{Fields: [2]uint{uint(field), uint(field)}, Collapsed: field})
+			}
+
+			scoreQuantum(game)
+
+			require.True(t, tc.score1.Equal(game.Quantum.Score1), "score 1 %s", game.Quantum.Score1)
+			require.True(t, tc.score2.Equal(game.Quantum.Score2), "score 2 %s", game.Quantum.Score2)
+		})
+	}
+}
+
+func TestQuantumCycle(t *testing.T) {
+	input := createTestInput(t)
+	p := DefaultParams()
+	p.EnabledVariants = []string{VariantClassic, VariantQuantum}
+	input.k.SetParams(input.ctx, p)
+
+	alice := input.fund(t, "alice", "100abc")
+	bob := input.fund(t, "bob", "100abc")
+	game, res := input.k.InviteGame(input.ctx, alice, bob, VariantQuantum, sdk.Coins{}, sdk.Coins{}, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.AcceptGame(input.ctx, game.Id, bob).IsOK())
+
+	require.True(t, input.k.QuantumMove(input.ctx, game.Id, alice, 0, 1).IsOK())
+	require.False(t, input.k.QuantumMove(input.ctx, game.Id, bob, 0, 0).IsOK())
+	require.True(t, input.k.QuantumMove(input.ctx, game.Id, bob, 0, 1).IsOK())
+	require.Equal(t, uint(2), input.k.getGame(input.ctx, game.Id).Quantum.PendingCollapse)
+
+	// The player who did not close the cycle collapses it before moving on
+	require.False(t, input.k.QuantumMove(input.ctx, game.Id, alice, 2, 3).IsOK())
+	require.False(t, input.k.Collapse(input.ctx, game.Id, alice, 5).IsOK())
+	require.True(t, input.k.Collapse(input.ctx, game.Id, alice, 0).IsOK())
+
+	game = input.k.getGame(input.ctx, game.Id)
+	require.Equal(t, uint(0), game.Quantum.PendingCollapse)
+	require.Equal(t, testFields(2, 1), game.Fields)
+	require.False(t, input.k.QuantumMove(input.ctx, game.Id, alice, 0, 2).IsOK())
+	require.True(t, input.k.QuantumMove(input.ctx, game.Id, alice, 2, 3).IsOK())
+}
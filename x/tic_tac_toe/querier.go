@@ -9,7 +9,16 @@ import (
 )
 
 const (
-	QueryGame = "game"
+	QueryGame       = "game"
+	QueryGames      = "games"
+	QueryParams     = "params"
+	QueryTournament = "tournament"
+	QueryLeague     = "league"
+	QuerySeason     = "season"
+	QueryMatch      = "match"
+	QueryBlindRound = "blind"
+	QueryPlayGrants = "grants"
+	QueryAllowance  = "allowance"
 )
 
 func NewQuerier(keeper Keeper) sdkTypes.Querier {
@@ -17,6 +26,24 @@ func NewQuerier(keeper Keeper) sdkTypes.Querier {
 		switch path[0] {
 		case QueryGame:
 			return queryGame(ctx, path[1:], req, keeper)
+		case QueryGames:
+			return queryGames(ctx, path[1:], req, keeper)
+		case QueryParams:
+			return queryParams(ctx, req, keeper)
+		case QueryTournament:
+			return queryTournament(ctx, path[1:], req, keeper)
+		case QueryLeague:
+			return queryLeague(ctx, path[1:], req, keeper)
+		case QuerySeason:
+			return querySeason(ctx, path[1:], req, keeper)
+		case QueryMatch:
+			return queryMatch(ctx, path[1:], req, keeper)
+		case QueryBlindRound:
+			return queryBlindRound(ctx, path[1:], req, keeper)
+		case QueryPlayGrants:
+			return queryPlayGrants(ctx, path[1:], req, keeper)
+		case QueryAllowance:
+			return queryAllowance(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdkTypes.ErrUnknownRequest("unknown kyc query endpoint")
 		}
@@ -43,3 +70,182 @@ func queryGame(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keepe
 
 	return gameJson, nil
 }
+
+// Games of a player, oldest first
+func queryGames(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	player, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrInvalidAddress(fmt.Sprintf("Bad player address %s", err))
+	}
+
+	games := []*Game{}
+	for _, id := range keeper.getPlayerGames(ctx, player) {
+		games = append(games, keeper.getGame(ctx, id))
+	}
+
+	gamesJson, err := json.Marshal(games)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode games"))
+	}
+
+	return gamesJson, nil
+}
+
+func queryParams(ctx sdkTypes.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	paramsJson, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode params"))
+	}
+
+	return paramsJson, nil
+}
+
+func queryTournament(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	id, err := strconv.Atoi(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Bad tournament id %s", err))
+	}
+
+	tournament := keeper.getTournament(ctx, uint(id))
+	if tournament == nil {
+		return nil, sdkTypes.ErrUnknownRequest("No such tournament")
+	}
+
+	tournamentJson, err := json.Marshal(tournament)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode tournament"))
+	}
+
+	return tournamentJson, nil
+}
+
+func queryLeague(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	id, err := strconv.Atoi(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Bad league id %s", err))
+	}
+
+	league := keeper.getLeague(ctx, uint(id))
+	if league == nil {
+		return nil, sdkTypes.ErrUnknownRequest("No such league")
+	}
+
+	leagueJson, err := json.Marshal(league)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode league"))
+	}
+
+	return leagueJson, nil
+}
+
+// Path is the league id and optionally the season number, the current season is returned without one
+func querySeason(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	id, err := strconv.Atoi(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Bad league id %s", err))
+	}
+
+	league := keeper.getLeague(ctx, uint(id))
+	if league == nil {
+		return nil, sdkTypes.ErrUnknownRequest("No such league")
+	}
+
+	number := league.Season
+	if len(path) > 1 {
+		n, err := strconv.Atoi(path[1])
+		if err != nil {
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Bad season number %s", err))
+		}
+
+		number = uint(n)
+	}
+
+	season := keeper.getSeason(ctx, league.Id, number)
+	if season == nil {
+		return nil, sdkTypes.ErrUnknownRequest("No such season")
+	}
+
+	seasonJson, err := json.Marshal(season)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode season"))
+	}
+
+	return seasonJson, nil
+}
+
+func queryMatch(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	id, err := strconv.Atoi(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Bad match id %s", err))
+	}
+
+	match := keeper.getMatch(ctx, uint(id))
+	if match == nil {
+		return nil, sdkTypes.ErrUnknownRequest("No such match")
+	}
+
+	matchJson, err := json.Marshal(match)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode match"))
+	}
+
+	return matchJson, nil
+}
+
+// Commitments of the round being played, fields are only known once revealed
+func queryBlindRound(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	id, err := strconv.Atoi(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Bad game id %s", err))
+	}
+
+	game := keeper.getGame(ctx, uint(id))
+	if game == nil || !isBlindVariant(game.Variant) {
+		return nil, sdkTypes.ErrUnknownRequest("No such blind game")
+	}
+
+	roundJson, err := json.Marshal(keeper.getBlindRound(ctx, game.Id))
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode blind round"))
+	}
+
+	return roundJson, nil
+}
+
+func queryPlayGrants(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	id, err := strconv.Atoi(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Bad game id %s", err))
+	}
+
+	grantsJson, err := json.Marshal(keeper.getPlayGrants(ctx, uint(id)))
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode play grants"))
+	}
+
+	return grantsJson, nil
+}
+
+// Gas allowance of a game or tournament, path is the scope and the id
+func queryAllowance(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	if len(path) < 2 || !isKnownAllowanceScope(path[0]) {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Path has to be %s or %s and an id", AllowanceGame, AllowanceTournament))
+	}
+
+	id, err := strconv.Atoi(path[1])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Bad id %s", err))
+	}
+
+	allowance := keeper.getGasAllowance(ctx, path[0], uint(id))
+	if allowance == nil {
+		allowance = &GasAllowance{Scope: path[0], Id: uint(id), Sponsors: []Sponsorship{}}
+	}
+
+	allowanceJson, err := json.Marshal(allowance)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode gas allowance"))
+	}
+
+	return allowanceJson, nil
+}
@@ -0,0 +1,324 @@
+package tic_tac_toe
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// Gas allowances pay the fees of moves, either in a single game or in every game of a tournament
+const (
+	AllowanceGame       = "game"
+	AllowanceTournament = "tournament"
+)
+
+func isKnownAllowanceScope(scope string) bool {
+	return scope == AllowanceGame || scope == AllowanceTournament
+}
+
+type GasAllowance struct {
+	Scope string `json:"scope"`
+	Id    uint   `json:"id"`
+	// What is left of every contribution, fees are drawn from the earliest one first
+	Sponsors []Sponsorship `json:"sponsors"`
+}
+
+func (allowance GasAllowance) Remaining() sdk.Coins {
+	remaining := sdk.Coins{}
+	for _, sponsor := range allowance.Sponsors {
+		remaining = remaining.Add(sponsor.Amount)
+	}
+
+	return remaining
+}
+
+// Takes the fee from the contributions, the allowance has to cover it
+func (allowance *GasAllowance) draw(fee sdk.Coins) {
+	need := fee
+	sponsors := []Sponsorship{}
+
+	for _, sponsor := range allowance.Sponsors {
+		take := sdk.Coins{}
+		for _, coin := range need {
+			amount := sdk.MinInt(coin.Amount, sponsor.Amount.AmountOf(coin.Denom))
+			if amount.IsPositive() {
+				take = append(take, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+
+		sponsor.Amount = sponsor.Amount.Sub(take)
+		need = need.Sub(take)
+
+		if !sponsor.Amount.IsZero() {
+			sponsors = append(sponsors, sponsor)
+		}
+	}
+
+	allowance.Sponsors = sponsors
+}
+
+func gasAllowanceKey(scope string, id uint) []byte {
+	return []byte(fmt.Sprintf("gas_allowance:%s:%d", scope, id))
+}
+
+func (k Keeper) storeGasAllowance(ctx sdk.Context, allowance *GasAllowance) {
+	store := ctx.KVStore(k.key)
+	store.Set(gasAllowanceKey(allowance.Scope, allowance.Id), k.cdc.MustMarshalJSON(allowance))
+}
+
+func (k Keeper) getGasAllowance(ctx sdk.Context, scope string, id uint) *GasAllowance {
+	store := ctx.KVStore(k.key)
+	value := store.Get(gasAllowanceKey(scope, id))
+	if value == nil {
+		return nil
+	}
+
+	allowance := new(GasAllowance)
+	if err := k.cdc.UnmarshalJSON(value, allowance); err != nil {
+		panic(fmt.Sprintf("Invalid gas allowance stored: %s", err))
+	}
+
+	return allowance
+}
+
+// Gives what is left back to the sponsors once the game or tournament is over
+func (k Keeper) refundGasAllowance(ctx sdk.Context, scope string, id uint) {
+	allowance := k.getGasAllowance(ctx, scope, id)
+	if allowance == nil {
+		return
+	}
+
+	for _, sponsor := range allowance.Sponsors {
+		k.addCoins(ctx, sponsor.Sponsor, sponsor.Amount)
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Delete(gasAllowanceKey(scope, id))
+}
+
+// FundGasAllowance adds to the allowance of a game, which only its creator can fund, or of a
+// tournament, which only its organiser can fund. The treasury funds through governance.
+func (k Keeper) FundGasAllowance(ctx sdk.Context, sponsor sdk.AccAddress, scope string, id uint, amount sdk.Coins) sdk.Result {
+	switch scope {
+	case AllowanceGame:
+		game := k.getGame(ctx, id)
+		if game == nil {
+			return sdk.ErrUnknownRequest("No such game").Result()
+		}
+
+		if !game.Player1.Equals(sponsor) {
+			return sdk.ErrUnauthorized("Only the player who started the game can fund its gas").Result()
+		}
+	case AllowanceTournament:
+		tournament := k.getTournament(ctx, id)
+		if tournament == nil {
+			return sdk.ErrUnknownRequest("No such tournament").Result()
+		}
+
+		if !tournament.Organiser.Equals(sponsor) {
+			return sdk.ErrUnauthorized("Only the organiser can fund the gas of a tournament").Result()
+		}
+	}
+
+	if err := k.subtractCoins(ctx, sponsor, amount); err != nil {
+		return err.Result()
+	}
+
+	if err := k.addGasAllowance(ctx, sponsor, scope, id, amount); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{}
+}
+
+// Adds funds which are already taken from the sponsor
+func (k Keeper) addGasAllowance(ctx sdk.Context, sponsor sdk.AccAddress, scope string, id uint, amount sdk.Coins) sdk.Error {
+	switch scope {
+	case AllowanceGame:
+		game := k.getGame(ctx, id)
+		if game == nil || game.Winner != WinnerNone {
+			return sdk.ErrUnknownRequest("Game is not being played")
+		}
+
+		// Players who never held tokens have no account, they could not sign with an account number otherwise
+		for _, player := range []sdk.AccAddress{game.Player1, game.Player2} {
+			if k.accountKeeper.GetAccount(ctx, player) == nil {
+				k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, player))
+			}
+		}
+	case AllowanceTournament:
+		tournament := k.getTournament(ctx, id)
+		if tournament == nil || tournament.Status == TournamentFinished || tournament.Status == TournamentCancelled {
+			return sdk.ErrUnknownRequest("Tournament is over")
+		}
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Unknown allowance scope %s", scope))
+	}
+
+	allowance := k.getGasAllowance(ctx, scope, id)
+	if allowance == nil {
+		allowance = &GasAllowance{Scope: scope, Id: id}
+	}
+
+	allowance.Sponsors = append(allowance.Sponsors, Sponsorship{Sponsor: sponsor, Amount: amount})
+	k.storeGasAllowance(ctx, allowance)
+
+	return nil
+}
+
+// Sponsored transactions an account sent at a height
+type sponsoredQuota struct {
+	Height int64 `json:"height"`
+	Count  int64 `json:"count"`
+}
+
+func sponsoredQuotaKey(addr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("gas_quota:%s", addr))
+}
+
+// Counts a sponsored transaction unless the account used up its quota for this block
+func (k Keeper) takeSponsoredQuota(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.key)
+
+	var quota sponsoredQuota
+	if value := store.Get(sponsoredQuotaKey(addr)); value != nil {
+		k.cdc.MustUnmarshalJSON(value, &quota)
+	}
+
+	if quota.Height != ctx.BlockHeight() {
+		quota = sponsoredQuota{Height: ctx.BlockHeight()}
+	}
+
+	if quota.Count >= k.GetParams(ctx).SponsoredMovesPerBlock {
+		return false
+	}
+
+	quota.Count++
+	store.Set(sponsoredQuotaKey(addr), k.cdc.MustMarshalJSON(quota))
+
+	return true
+}
+
+// The game and player of a transaction which only holds moves of one player in one game. Grants
+// only cover MsgPlay, so other moves have to come from the player.
+func sponsoredMove(msgs []sdk.Msg) (gameID uint, player sdk.AccAddress, grantable bool, ok bool) {
+	grantable = true
+
+	for i, msg := range msgs {
+		var id uint
+		var signer sdk.AccAddress
+
+		switch msg := msg.(type) {
+		case MsgPlay:
+			id, signer = msg.GameId, msg.Player
+		case MsgCommitToss:
+			id, signer, grantable = msg.GameId, msg.Player, false
+		case MsgRevealToss:
+			id, signer, grantable = msg.GameId, msg.Player, false
+		case MsgCommitMove:
+			id, signer, grantable = msg.GameId, msg.Player, false
+		case MsgRevealMove:
+			id, signer, grantable = msg.GameId, msg.Player, false
+		case MsgQuantumMove:
+			id, signer, grantable = msg.GameId, msg.Player, false
+		case MsgCollapse:
+			id, signer, grantable = msg.GameId, msg.Player, false
+		case MsgForceMove:
+			id, signer, grantable = msg.GameId, msg.Player, false
+		default:
+			return 0, nil, false, false
+		}
+
+		if i > 0 && (id != gameID || !signer.Equals(player)) {
+			return 0, nil, false, false
+		}
+
+		gameID, player = id, signer
+	}
+
+	return gameID, player, grantable, len(msgs) > 0
+}
+
+// Moves the fee of a move, up to the max sponsored fee, from its allowance to the player, who then pays
+// it like any other fee
+func (k Keeper) sponsorFee(ctx sdk.Context, tx auth.StdTx) {
+	fee := k.GetParams(ctx).SponsoredFee(tx.Fee.Amount)
+	if fee.IsZero() {
+		return
+	}
+
+	gameID, player, grantable, ok := sponsoredMove(tx.GetMsgs())
+	if !ok {
+		return
+	}
+
+	game := k.getGame(ctx, gameID)
+	if game == nil || game.Winner != WinnerNone {
+		return
+	}
+
+	if !game.Player1.Equals(player) && !game.Player2.Equals(player) {
+		if _, err := k.seatOf(ctx, game, player); !grantable || err != nil {
+			return
+		}
+	}
+
+	allowance := k.getGasAllowance(ctx, AllowanceGame, game.Id)
+	if (allowance == nil || !allowance.Remaining().IsAllGTE(fee)) && game.TournamentId != 0 {
+		allowance = k.getGasAllowance(ctx, AllowanceTournament, game.TournamentId)
+	}
+
+	if allowance == nil || !allowance.Remaining().IsAllGTE(fee) {
+		return
+	}
+
+	if !k.movesGoThrough(ctx, tx.GetMsgs()) {
+		return
+	}
+
+	if !k.takeSponsoredQuota(ctx, player) {
+		return
+	}
+
+	allowance.draw(fee)
+	k.storeGasAllowance(ctx, allowance)
+	k.addCoins(ctx, player, fee)
+}
+
+// Only moves which are valid, like a move of the player whose turn it is, are paid for. They are tried
+// on a copy of the state which is thrown away.
+func (k Keeper) movesGoThrough(ctx sdk.Context, msgs []sdk.Msg) bool {
+	cacheCtx, _ := ctx.CacheContext()
+	handler := NewHandler(k)
+
+	for _, msg := range msgs {
+		if !handler(cacheCtx, msg).IsOK() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewAnteHandler pays the fees of moves from gas allowances and then hands the transaction to next,
+// which checks the signatures and deducts the fees as usual. Next sets up the gas meter of the
+// transaction, so the gas of the sponsoring is counted on a meter of its own and added to it.
+func NewAnteHandler(k Keeper, next sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		stdTx, ok := tx.(auth.StdTx)
+		if !ok {
+			return next(ctx, tx, simulate)
+		}
+
+		sponsorCtx := auth.SetGasMeter(simulate, ctx, stdTx.Fee.Gas)
+		k.sponsorFee(sponsorCtx, stdTx)
+
+		newCtx, res, abort := next(ctx, tx, simulate)
+		if !abort {
+			newCtx.GasMeter().ConsumeGas(sponsorCtx.GasMeter().GasConsumed(), "gas allowance")
+		}
+
+		return newCtx, res, abort
+	}
+}
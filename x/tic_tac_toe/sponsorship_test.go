@@ -0,0 +1,83 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSponsorFee(t *testing.T) {
+	tests := []struct {
+		name string
+		// Player who sends a move on field 4, alice moves first and takes it already when taken is set
+		player    string
+		taken     bool
+		fee       string
+		allowance string
+		sponsored string
+	}{
+		{"move within the cap", "alice", false, "500stake", "2000stake", "500stake"},
+		{"fee above the cap", "alice", false, "5000stake", "2000stake", "1000stake"},
+		{"denom not sponsored", "alice", false, "100abc", "2000stake,100abc", ""},
+		{"not the player's turn", "bob", false, "500stake", "2000stake", ""},
+		{"field taken", "bob", true, "500stake", "2000stake", ""},
+		{"allowance too small", "alice", false, "500stake", "400stake", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			players := map[string]sdk.AccAddress{"alice": input.fund(t, "alice", "100abc"), "bob": input.fund(t, "bob", "100abc")}
+
+			game, res := input.k.InviteGame(input.ctx, players["alice"], players["bob"], VariantClassic, sdk.Coins{}, sdk.Coins{}, 0)
+			require.True(t, res.IsOK(), res.Log)
+			require.True(t, input.k.AcceptGame(input.ctx, game.Id, players["bob"]).IsOK())
+			if tc.taken {
+				require.True(t, input.k.Play(input.ctx, game.Id, players["alice"], 4).IsOK())
+			}
+
+			allowance := mustParseCoins(t, tc.allowance)
+			require.NoError(t, input.k.addGasAllowance(input.ctx, testAddress("sponsor"), AllowanceGame, game.Id, allowance))
+
+			player := players[tc.player]
+			before := input.balance(player)
+			tx := auth.NewStdTx([]sdk.Msg{NewMsgPlay(game.Id, player, 4)},
+				auth.NewStdFee(200000, mustParseCoins(t, tc.fee)), nil, "")
+			input.k.sponsorFee(input.ctx, tx)
+
+			sponsored := mustParseCoins(t, tc.sponsored)
+			require.Equal(t, before.Add(sponsored), input.balance(player))
+			require.Equal(t, allowance.Sub(sponsored), input.k.getGasAllowance(input.ctx, AllowanceGame, game.Id).Remaining())
+		})
+	}
+}
+
+func TestSponsoredGasIsMetered(t *testing.T) {
+	input := createTestInput(t)
+	alice := input.fund(t, "alice", "100abc")
+	bob := input.fund(t, "bob", "100abc")
+
+	game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic, sdk.Coins{}, sdk.Coins{}, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.AcceptGame(input.ctx, game.Id, bob).IsOK())
+	require.NoError(t, input.k.addGasAllowance(input.ctx, testAddress("sponsor"), AllowanceGame, game.Id,
+		mustParseCoins(t, "2000stake")))
+
+	// Stands in for the auth ante handler, which starts a fresh gas meter
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		return auth.SetGasMeter(simulate, ctx, tx.(auth.StdTx).Fee.Gas), sdk.Result{}, false
+	}
+	anteHandler := NewAnteHandler(input.k, next)
+
+	tx := auth.NewStdTx([]sdk.Msg{NewMsgPlay(game.Id, alice, 4)}, auth.NewStdFee(200000, mustParseCoins(t, "500stake")), nil, "")
+	newCtx, _, abort := anteHandler(input.ctx, tx, false)
+	require.False(t, abort)
+	require.True(t, newCtx.GasMeter().GasConsumed() > 0)
+	require.Equal(t, mustParseCoins(t, "100abc,500stake"), input.balance(alice))
+
+	// Too little gas for the sponsoring fails the transaction instead of running it unmetered
+	tx = auth.NewStdTx([]sdk.Msg{NewMsgPlay(game.Id, alice, 4)}, auth.NewStdFee(10, mustParseCoins(t, "500stake")), nil, "")
+	require.Panics(t, func() { anteHandler(input.ctx, tx, false) })
+}
@@ -0,0 +1,62 @@
+package tic_tac_toe
+
+// Tag keys emitted by the module
+const (
+	TagGameId     = "game-id"
+	TagNextPlayer = "next-player"
+	// The opponent of a game waiting for them to accept the invite
+	TagInvitee = "invitee"
+
+	// Both players of the game and every coin of its pot, on every action of the game
+	TagPlayer      = "player"
+	TagStakeDenom  = "stake-denom"
+	TagStakeAmount = "stake-amount"
+
+	// Emitted once a game is over, the winner is left out of a draw or a cancelled game
+	TagOutcome = "outcome"
+	TagWinner  = "winner"
+
+	TagFirstPlayer = "first-player"
+
+	TagBlindRound     = "blind-round"
+	TagBlindCollision = "blind-collision"
+
+	TagQuantumMove     = "quantum-move"
+	TagQuantumCycle    = "quantum-cycle"
+	TagQuantumCollapse = "quantum-collapse"
+
+	TagChannelNonce     = "channel-nonce"
+	TagChannelChallenge = "channel-challenge"
+
+	TagHouseMove = "house-move"
+
+	TagPayoutGross = "payout-gross"
+	TagPayoutRake  = "payout-rake"
+	TagPayoutNet   = "payout-net"
+
+	TagTournamentId     = "tournament-id"
+	TagTournamentRound  = "tournament-round"
+	TagTournamentStatus = "tournament-status"
+	TagTournamentWinner = "tournament-winner"
+
+	TagLeagueId     = "league-id"
+	TagLeagueSeason = "league-season"
+	TagLeagueRound  = "league-round"
+	TagLeagueStatus = "league-status"
+	TagLeagueWinner = "league-winner"
+
+	TagMatchId     = "match-id"
+	TagMatchGame   = "match-game"
+	TagMatchWinner = "match-winner"
+
+	TagProposalId = "proposal-id"
+	// Whether a passed game proposal could be applied, emitted by the EndBlocker
+	TagProposalApplied = "proposal-applied"
+)
+
+// Values of TagOutcome
+const (
+	OutcomeWin       = "win"
+	OutcomeDraw      = "draw"
+	OutcomeCancelled = "cancelled"
+)
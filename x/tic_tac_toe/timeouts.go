@@ -0,0 +1,99 @@
+package tic_tac_toe
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"strconv"
+)
+
+// Deadlines are indexed by height so the EndBlocker only touches games which ran out of time
+func deadlineKey(height int64, gameID uint) []byte {
+	return []byte(fmt.Sprintf("deadline:%020d:%d", height, gameID))
+}
+
+func (k Keeper) resetDeadline(ctx sdk.Context, game *Game) {
+	k.clearDeadline(ctx, game)
+
+	// Reveals always have a deadline, otherwise a player could hold the stakes forever by not revealing. Blind
+	// commits without a move timeout get the reveal timeout as well, for the same reason.
+	// The clock only starts once the opponent accepts, until then the move timeout is how long the invite stays open.
+	timeout := game.MoveTimeout
+	if !game.AwaitingAccept && ((game.Toss != nil && !game.Toss.decided()) || k.blindRevealing(ctx, game) ||
+		(isBlindVariant(game.Variant) && timeout <= 0)) {
+		timeout = k.GetParams(ctx).RevealTimeout
+	}
+
+	// Channel games are played off-chain, only a unilateral close has to be answered in time
+	if game.Channel != nil {
+		timeout = 0
+		if game.Channel.Challenged {
+			timeout = k.GetParams(ctx).ChallengePeriod
+		}
+	}
+
+	if timeout <= 0 {
+		return
+	}
+
+	game.Deadline = ctx.BlockHeight() + timeout
+
+	store := ctx.KVStore(k.key)
+	store.Set(deadlineKey(game.Deadline, game.Id), []byte(strconv.Itoa(int(game.Id))))
+}
+
+func (k Keeper) clearDeadline(ctx sdk.Context, game *Game) {
+	if game.Deadline == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Delete(deadlineKey(game.Deadline, game.Id))
+	game.Deadline = 0
+}
+
+func (k Keeper) getExpiredGames(ctx sdk.Context) []uint {
+	store := ctx.KVStore(k.key)
+	iterator := store.Iterator([]byte("deadline:"), deadlineKey(ctx.BlockHeight()+1, 0))
+	defer iterator.Close()
+
+	var ids []uint
+	for ; iterator.Valid(); iterator.Next() {
+		id, err := strconv.Atoi(string(iterator.Value()))
+		if err != nil {
+			panic(fmt.Sprintf("Invalid game id: %v", iterator.Value()))
+		}
+
+		ids = append(ids, uint(id))
+	}
+
+	return ids
+}
+
+// The player who should have moved loses the game, or the player who did not commit or reveal.
+// An invite nobody accepted in time is cancelled.
+func (k Keeper) processTimeouts(ctx sdk.Context) sdk.Tags {
+	resTags := sdk.NewTags()
+
+	for _, gameID := range k.getExpiredGames(ctx) {
+		game := k.getGame(ctx, gameID)
+		if game == nil || game.Winner != WinnerNone {
+			continue
+		}
+
+		if game.AwaitingAccept {
+			game.Winner = WinnerCancelled
+		} else if game.Toss != nil && !game.Toss.decided() {
+			game.Winner = game.Toss.forfeitWinner()
+		} else if isBlindVariant(game.Variant) {
+			game.Winner = k.blindForfeitWinner(ctx, game)
+		} else {
+			game.Winner = otherPlayer(game.PlayerToMove())
+		}
+
+		resTags = resTags.AppendTags(gameTags(game))
+		resTags = resTags.AppendTags(k.finishGame(ctx, game))
+		k.storeGame(ctx, game)
+	}
+
+	return resTags
+}
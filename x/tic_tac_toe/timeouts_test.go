@@ -0,0 +1,72 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeouts(t *testing.T) {
+	tests := []struct {
+		name string
+		// Height bob accepts the invite at, 0 if he never does
+		acceptAt int64
+		// Height timeouts are processed at
+		at     int64
+		winner uint
+		alice  string
+		bob    string
+	}{
+		{
+			name:  "open invite",
+			at:    5,
+			alice: "90abc",
+			bob:   "100abc",
+		},
+		{
+			name:   "invite expires",
+			at:     6,
+			winner: WinnerCancelled,
+			alice:  "100abc",
+			bob:    "100abc",
+		},
+		{
+			name:     "clock starts at the accept",
+			acceptAt: 5,
+			at:       9,
+			alice:    "90abc",
+			bob:      "90abc",
+		},
+		{
+			name:     "player to move loses on time",
+			acceptAt: 5,
+			at:       10,
+			winner:   2,
+			alice:    "90abc",
+			bob:      "110abc",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			alice := input.fund(t, "alice", "100abc")
+			bob := input.fund(t, "bob", "100abc")
+
+			game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic,
+				mustParseCoins(t, "10abc"), mustParseCoins(t, "10abc"), 5)
+			require.True(t, res.IsOK(), res.Log)
+
+			if tc.acceptAt != 0 {
+				res := input.k.AcceptGame(input.ctx.WithBlockHeight(tc.acceptAt), game.Id, bob)
+				require.True(t, res.IsOK(), res.Log)
+			}
+
+			input.k.processTimeouts(input.ctx.WithBlockHeight(tc.at))
+
+			require.Equal(t, tc.winner, input.k.getGame(input.ctx, game.Id).Winner)
+			require.Equal(t, mustParseCoins(t, tc.alice), input.balance(alice))
+			require.Equal(t, mustParseCoins(t, tc.bob), input.balance(bob))
+		})
+	}
+}
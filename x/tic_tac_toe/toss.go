@@ -0,0 +1,156 @@
+package tic_tac_toe
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// Secrets have a fixed length so neither player can shorten the part of the XOR the other one controls
+const TossSecretLength = 32
+
+// Toss decides who moves first. Both players commit to a secret, once both secrets are
+// revealed the last bit of their XOR picks the player who plays X.
+type Toss struct {
+	Commitment1 []byte `json:"commitment_1"`
+	Commitment2 []byte `json:"commitment_2"`
+	Secret1     []byte `json:"secret_1"`
+	Secret2     []byte `json:"secret_2"`
+}
+
+// The commitment covers the address so a player can not copy the commitment of the other one
+func TossCommitment(player sdk.AccAddress, secret []byte) []byte {
+	return tmhash.Sum(append(append([]byte{}, player...), secret...))
+}
+
+func (toss Toss) decided() bool {
+	return len(toss.Secret1) > 0 && len(toss.Secret2) > 0
+}
+
+func (toss Toss) firstPlayer() uint {
+	last := len(toss.Secret1) - 1
+	return uint((toss.Secret1[last]^toss.Secret2[last])&1) + 1
+}
+
+// Winner of a toss which ran out of time: a player who did their part wins against one who didn't.
+// A toss player 2 never committed to is cancelled and both players get their stakes back.
+func (toss Toss) forfeitWinner() uint {
+	if len(toss.Commitment2) == 0 {
+		return WinnerCancelled
+	}
+
+	revealed1, revealed2 := len(toss.Secret1) > 0, len(toss.Secret2) > 0
+	switch {
+	case revealed1 && !revealed2:
+		return 1
+	case revealed2 && !revealed1:
+		return 2
+	default:
+		return WinnerDraw
+	}
+}
+
+// Replaces the first move of player 1 by a toss, player 1 commits right away
+func (k Keeper) startToss(ctx sdk.Context, game *Game, commitment []byte) {
+	game.Toss = &Toss{Commitment1: commitment}
+	game.FirstPlayer = 0
+
+	k.resetDeadline(ctx, game)
+	k.storeGame(ctx, game)
+}
+
+func (k Keeper) getTossGame(ctx sdk.Context, gameID uint, player sdk.AccAddress) (*Game, sdk.Error) {
+	game := k.getGame(ctx, gameID)
+	if game == nil {
+		return nil, sdk.ErrUnknownRequest("No such game")
+	}
+
+	if !game.Player1.Equals(player) && !game.Player2.Equals(player) {
+		return nil, sdk.ErrUnauthorized("Not playing in this game")
+	}
+
+	if game.Winner != WinnerNone {
+		return nil, sdk.ErrUnknownRequest("Game already finished")
+	}
+
+	if game.AwaitingAccept {
+		return nil, sdk.ErrUnknownRequest("The invite is not accepted yet")
+	}
+
+	if game.Toss == nil || game.Toss.decided() {
+		return nil, sdk.ErrUnknownRequest("Game has no open toss")
+	}
+
+	return game, nil
+}
+
+// CommitToss stores the commitment of player 2, player 1 committed when starting the game
+func (k Keeper) CommitToss(ctx sdk.Context, gameID uint, player sdk.AccAddress, commitment []byte) sdk.Result {
+	game, err := k.getTossGame(ctx, gameID, player)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !game.Player2.Equals(player) || len(game.Toss.Commitment2) > 0 {
+		return sdk.ErrUnknownRequest("Already committed").Result()
+	}
+
+	game.Toss.Commitment2 = commitment
+
+	k.resetDeadline(ctx, game)
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: gameTags(game)}
+}
+
+// RevealToss checks the secret against the commitment and decides the toss once both secrets are known
+func (k Keeper) RevealToss(ctx sdk.Context, gameID uint, player sdk.AccAddress, secret []byte) sdk.Result {
+	game, err := k.getTossGame(ctx, gameID, player)
+	if err != nil {
+		return err.Result()
+	}
+
+	toss := game.Toss
+	if len(toss.Commitment2) == 0 {
+		return sdk.ErrUnknownRequest("Player 2 has not committed yet").Result()
+	}
+
+	commitment, revealed := toss.Commitment1, &toss.Secret1
+	if game.Player2.Equals(player) {
+		commitment, revealed = toss.Commitment2, &toss.Secret2
+	}
+
+	if len(*revealed) > 0 {
+		return sdk.ErrUnknownRequest("Already revealed").Result()
+	}
+
+	if !bytes.Equal(TossCommitment(player, secret), commitment) {
+		return sdk.ErrUnauthorized("Secret does not match the commitment").Result()
+	}
+
+	*revealed = secret
+
+	resTags := gameTags(game)
+	if toss.decided() {
+		game.FirstPlayer = toss.firstPlayer()
+		resTags = turnTags(game).AppendTag(TagFirstPlayer, strconv.Itoa(int(game.FirstPlayer)))
+
+		k.resetDeadline(ctx, game)
+	}
+
+	k.storeGame(ctx, game)
+
+	return sdk.Result{Tags: resTags}
+}
+
+// Toss and blind move commitments are both plain hashes
+func validateTossCommitment(commitment []byte) sdk.Error {
+	if len(commitment) != tmhash.Size {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Commitment has to be %d bytes", tmhash.Size))
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package tic_tac_toe
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTossTimeouts(t *testing.T) {
+	secret1 := bytes.Repeat([]byte{1}, TossSecretLength)
+	secret2 := bytes.Repeat([]byte{2}, TossSecretLength)
+
+	tests := []struct {
+		name    string
+		commit2 bool
+		reveal1 bool
+		reveal2 bool
+		winner  uint
+		alice   string
+		bob     string
+	}{
+		{"player 2 never commits", false, false, false, WinnerCancelled, "100abc", "100abc"},
+		{"player 1 reveals alone", true, true, false, 1, "110abc", "90abc"},
+		{"player 2 reveals alone", true, false, true, 2, "90abc", "110abc"},
+		{"nobody reveals", true, false, false, WinnerDraw, "100abc", "100abc"},
+		{"toss decided", true, true, true, WinnerNone, "90abc", "90abc"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+			alice := input.fund(t, "alice", "100abc")
+			bob := input.fund(t, "bob", "100abc")
+			stake := mustParseCoins(t, "10abc")
+
+			game, res := input.k.InviteGame(input.ctx, alice, bob, VariantClassic, stake, stake, 0)
+			require.True(t, res.IsOK(), res.Log)
+			input.k.startToss(input.ctx, game, TossCommitment(alice, secret1))
+			require.True(t, input.k.AcceptGame(input.ctx, game.Id, bob).IsOK())
+
+			steps := []struct {
+				do  bool
+				run func() sdk.Result
+			}{
+				{tc.commit2, func() sdk.Result {
+					return input.k.CommitToss(input.ctx, game.Id, bob, TossCommitment(bob, secret2))
+				}},
+				{tc.reveal1, func() sdk.Result { return input.k.RevealToss(input.ctx, game.Id, alice, secret1) }},
+				{tc.reveal2, func() sdk.Result { return input.k.RevealToss(input.ctx, game.Id, bob, secret2) }},
+			}
+			for _, step := range steps {
+				if step.do {
+					res := step.run()
+					require.True(t, res.IsOK(), res.Log)
+				}
+			}
+
+			input.k.processTimeouts(input.ctx.WithBlockHeight(1 + DefaultParams().RevealTimeout))
+
+			game = input.k.getGame(input.ctx, game.Id)
+			require.Equal(t, tc.winner, game.Winner)
+			require.Equal(t, mustParseCoins(t, tc.alice), input.balance(alice))
+			require.Equal(t, mustParseCoins(t, tc.bob), input.balance(bob))
+			if tc.winner == WinnerNone {
+				require.Equal(t, uint(2), game.FirstPlayer)
+			}
+		})
+	}
+}
+
+func TestMatchTossNeverCommitted(t *testing.T) {
+	input := createTestInput(t)
+	alice := input.fund(t, "alice", "100abc")
+	bob := input.fund(t, "bob", "100abc")
+	stake := mustParseCoins(t, "10abc")
+
+	match, res := input.k.StartMatch(input.ctx, alice, bob, VariantClassic, stake, stake, 0, 3,
+		TossCommitment(alice, bytes.Repeat([]byte{1}, TossSecretLength)))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.k.AcceptGame(input.ctx, match.Games[0], bob).IsOK())
+
+	input.k.processTimeouts(input.ctx.WithBlockHeight(1 + DefaultParams().RevealTimeout))
+
+	require.Equal(t, WinnerCancelled, input.k.getMatch(input.ctx, match.Id).Winner)
+	require.Equal(t, mustParseCoins(t, "100abc"), input.balance(alice))
+	require.Equal(t, mustParseCoins(t, "100abc"), input.balance(bob))
+}
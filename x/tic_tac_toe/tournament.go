@@ -0,0 +1,499 @@
+package tic_tac_toe
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	FormatSingleElimination = "single_elimination"
+	FormatDoubleElimination = "double_elimination"
+
+	TournamentRegistration = "registration"
+	TournamentRunning      = "running"
+	TournamentFinished     = "finished"
+	TournamentCancelled    = "cancelled"
+
+	// Drawn games are replayed at most this often, then the higher seed advances
+	maxTournamentReplays = 2
+)
+
+type Entrant struct {
+	Address sdk.AccAddress `json:"address"`
+	Losses  uint           `json:"losses"`
+}
+
+type TournamentRound struct {
+	Games []uint `json:"games"`
+	// How often the game at the same index was replayed after a draw
+	Replays []uint           `json:"replays"`
+	Byes    []sdk.AccAddress `json:"byes"`
+}
+
+type Tournament struct {
+	Id        uint           `json:"id"`
+	Organiser sdk.AccAddress `json:"organiser"`
+	Format    string         `json:"format"`
+	Variant   string         `json:"variant"`
+	EntryFee  sdk.Coins      `json:"entry_fee"`
+	// Share of the prize pool for each place, first place first
+	PrizeShares []sdk.Dec `json:"prize_shares"`
+	MaxEntrants uint      `json:"max_entrants"`
+	// Time control of every game in the tournament
+	MoveTimeout int64     `json:"move_timeout"`
+	Status      string    `json:"status"`
+	PrizePool   sdk.Coins `json:"prize_pool"`
+	// In registration order until the tournament starts, in seeding order afterwards
+	Entrants []Entrant `json:"entrants"`
+	// The last round is the one being played
+	Rounds []TournamentRound `json:"rounds"`
+	// Entrants knocked out, in the order they were eliminated
+	Eliminated []sdk.AccAddress `json:"eliminated"`
+	// Final ranking, first place first
+	Standings []sdk.AccAddress `json:"standings"`
+}
+
+func (t Tournament) maxLosses() uint {
+	if t.Format == FormatDoubleElimination {
+		return 2
+	}
+
+	return 1
+}
+
+func (t Tournament) entrantIndex(addr sdk.AccAddress) int {
+	for i, entrant := range t.Entrants {
+		if entrant.Address.Equals(addr) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isKnownFormat(format string) bool {
+	return format == FormatSingleElimination || format == FormatDoubleElimination
+}
+
+func validatePrizeShares(shares []sdk.Dec, maxEntrants uint) sdk.Error {
+	if len(shares) == 0 {
+		return sdk.ErrUnknownRequest("No prize shares")
+	}
+
+	if uint(len(shares)) > maxEntrants {
+		return sdk.ErrUnknownRequest("More prize shares than entrants")
+	}
+
+	total := sdk.ZeroDec()
+	for _, share := range shares {
+		if share.IsNil() || !share.IsPositive() {
+			return sdk.ErrUnknownRequest("Prize shares have to be positive")
+		}
+
+		total = total.Add(share)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Prize shares have to add up to 1, they add up to %s", total))
+	}
+
+	return nil
+}
+
+func tournamentKey(id uint) []byte {
+	return []byte(fmt.Sprintf("tournament:%d", id))
+}
+
+func (k Keeper) nextTournamentId(ctx sdk.Context) uint {
+	store := ctx.KVStore(k.key)
+
+	var id int
+	idBytes := store.Get([]byte("tournament_id"))
+	if idBytes != nil {
+		var err error
+		id, err = strconv.Atoi(string(idBytes))
+		if err != nil {
+			panic(fmt.Sprintf("Invalid tournament id: %v", idBytes))
+		}
+	}
+
+	// Ids start at 1 so 0 can mean no tournament
+	id++
+	store.Set([]byte("tournament_id"), []byte(strconv.Itoa(id)))
+
+	return uint(id)
+}
+
+func (k Keeper) storeTournament(ctx sdk.Context, tournament *Tournament) {
+	store := ctx.KVStore(k.key)
+	store.Set(tournamentKey(tournament.Id), k.cdc.MustMarshalJSON(tournament))
+}
+
+func (k Keeper) getTournament(ctx sdk.Context, id uint) *Tournament {
+	store := ctx.KVStore(k.key)
+	value := store.Get(tournamentKey(id))
+	if value == nil {
+		return nil
+	}
+
+	tournament := new(Tournament)
+	if err := k.cdc.UnmarshalJSON(value, tournament); err != nil {
+		panic(fmt.Sprintf("Invalid tournament stored: %s", err))
+	}
+
+	return tournament
+}
+
+func (k Keeper) CreateTournament(ctx sdk.Context, organiser sdk.AccAddress, format, variant string, entryFee sdk.Coins,
+	prizeShares []sdk.Dec, maxEntrants uint, moveTimeout int64) (*Tournament, sdk.Result) {
+
+	if !k.GetParams(ctx).IsVariantEnabled(variant) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Variant %s is not enabled", variant)).Result()
+	}
+
+	tournament := &Tournament{
+		Id:          k.nextTournamentId(ctx),
+		Organiser:   organiser,
+		Format:      format,
+		Variant:     variant,
+		EntryFee:    entryFee,
+		PrizeShares: prizeShares,
+		MaxEntrants: maxEntrants,
+		MoveTimeout: moveTimeout,
+		Status:      TournamentRegistration,
+		PrizePool:   sdk.Coins{},
+	}
+
+	k.storeTournament(ctx, tournament)
+
+	return tournament, sdk.Result{}
+}
+
+// Register pays the entry fee into the prize pool
+func (k Keeper) Register(ctx sdk.Context, tournamentID uint, player sdk.AccAddress) sdk.Result {
+	tournament := k.getTournament(ctx, tournamentID)
+	if tournament == nil {
+		return sdk.ErrUnknownRequest("No such tournament").Result()
+	}
+
+	if tournament.Status != TournamentRegistration {
+		return sdk.ErrUnknownRequest("Registration is closed").Result()
+	}
+
+	if tournament.entrantIndex(player) >= 0 {
+		return sdk.ErrUnknownRequest("Already registered").Result()
+	}
+
+	if uint(len(tournament.Entrants)) >= tournament.MaxEntrants {
+		return sdk.ErrUnknownRequest("Tournament is full").Result()
+	}
+
+	if !tournament.EntryFee.IsZero() {
+		if err := k.subtractCoins(ctx, player, tournament.EntryFee); err != nil {
+			return err.Result()
+		}
+
+		tournament.PrizePool = tournament.PrizePool.Add(tournament.EntryFee)
+	}
+
+	tournament.Entrants = append(tournament.Entrants, Entrant{Address: player})
+	k.storeTournament(ctx, tournament)
+
+	return sdk.Result{}
+}
+
+func (k Keeper) StartTournament(ctx sdk.Context, tournamentID uint, organiser sdk.AccAddress) sdk.Result {
+	tournament := k.getTournament(ctx, tournamentID)
+	if tournament == nil {
+		return sdk.ErrUnknownRequest("No such tournament").Result()
+	}
+
+	if !tournament.Organiser.Equals(organiser) {
+		return sdk.ErrUnauthorized("Only the organiser can start the tournament").Result()
+	}
+
+	if tournament.Status != TournamentRegistration {
+		return sdk.ErrUnknownRequest("Tournament already started").Result()
+	}
+
+	if len(tournament.Entrants) < 2 {
+		return sdk.ErrUnknownRequest("Tournament needs at least 2 entrants").Result()
+	}
+
+	seedEntrants(tournament)
+	tournament.Status = TournamentRunning
+
+	resTags := k.startRound(ctx, tournament)
+	k.storeTournament(ctx, tournament)
+
+	return sdk.Result{Tags: resTags}
+}
+
+// Ends a tournament nobody plays yet, every entrant gets the entry fee back
+func (k Keeper) CancelTournament(ctx sdk.Context, tournamentID uint, organiser sdk.AccAddress) sdk.Result {
+	tournament := k.getTournament(ctx, tournamentID)
+	if tournament == nil {
+		return sdk.ErrUnknownRequest("No such tournament").Result()
+	}
+
+	if !tournament.Organiser.Equals(organiser) {
+		return sdk.ErrUnauthorized("Only the organiser can cancel the tournament").Result()
+	}
+
+	if tournament.Status != TournamentRegistration {
+		return sdk.ErrUnknownRequest("Only a tournament in registration can be cancelled").Result()
+	}
+
+	resTags := k.cancelTournament(ctx, tournament)
+	k.storeTournament(ctx, tournament)
+
+	return sdk.Result{Tags: resTags}
+}
+
+// Orders entrants by a hash of the tournament id and their address, so nobody can pick a
+// place in the bracket by registering at the right moment
+func seedEntrants(tournament *Tournament) {
+	seed := func(addr sdk.AccAddress) []byte {
+		return tmhash.Sum(append([]byte(fmt.Sprintf("%d:", tournament.Id)), addr...))
+	}
+
+	sort.SliceStable(tournament.Entrants, func(i, j int) bool {
+		return bytes.Compare(seed(tournament.Entrants[i].Address), seed(tournament.Entrants[j].Address)) < 0
+	})
+}
+
+// Pairs the remaining entrants. Entrants are grouped by the number of losses so the
+// double elimination losers bracket plays separately until both brackets have a champion.
+func (k Keeper) startRound(ctx sdk.Context, tournament *Tournament) sdk.Tags {
+	maxLosses := tournament.maxLosses()
+
+	groups := make([][]sdk.AccAddress, maxLosses)
+	var remaining int
+	for _, entrant := range tournament.Entrants {
+		if entrant.Losses < maxLosses {
+			groups[entrant.Losses] = append(groups[entrant.Losses], entrant.Address)
+			remaining++
+		}
+	}
+
+	if remaining < 2 {
+		return k.finishTournament(ctx, tournament)
+	}
+
+	// Grand final between the winners and the losers bracket champions
+	if maxLosses == 2 && len(groups[0]) == 1 && len(groups[1]) == 1 {
+		groups = [][]sdk.AccAddress{{groups[0][0], groups[1][0]}}
+	}
+
+	round := TournamentRound{}
+	roundNumber := len(tournament.Rounds)
+
+	// Every game of the round is tagged, the tags of a block keep all of them
+	var gameTags sdk.Tags
+
+	for _, group := range groups {
+		if len(group)%2 == 1 {
+			round.Byes = append(round.Byes, group[0])
+			group = group[1:]
+		}
+
+		// Highest seed plays the lowest one, who moves first alternates every round
+		for i := 0; i < len(group)/2; i++ {
+			player1, player2 := group[i], group[len(group)-1-i]
+			if roundNumber%2 == 1 {
+				player1, player2 = player2, player1
+			}
+
+			game, res := k.StartGame(ctx, player1, player2, tournament.Variant, sdk.Coins{}, sdk.Coins{}, tournament.MoveTimeout)
+			if game == nil {
+				ctx.Logger().With("module", "x/tictactoe").Info(
+					fmt.Sprintf("tournament %d cancelled: %s", tournament.Id, res.Log))
+				return k.cancelTournament(ctx, tournament)
+			}
+
+			game.TournamentId = tournament.Id
+			k.storeGame(ctx, game)
+
+			round.Games = append(round.Games, game.Id)
+			round.Replays = append(round.Replays, 0)
+			gameTags = gameTags.AppendTags(turnTags(game))
+		}
+	}
+
+	tournament.Rounds = append(tournament.Rounds, round)
+
+	return sdk.NewTags(
+		TagTournamentId, strconv.Itoa(int(tournament.Id)),
+		TagTournamentRound, strconv.Itoa(len(tournament.Rounds)),
+	).AppendTags(gameTags)
+}
+
+// Called when a tournament game has a result. The game itself is stored by the caller.
+func (k Keeper) tournamentGameFinished(ctx sdk.Context, game *Game) sdk.Tags {
+	tournament := k.getTournament(ctx, game.TournamentId)
+	if tournament == nil || tournament.Status != TournamentRunning {
+		return nil
+	}
+
+	round := &tournament.Rounds[len(tournament.Rounds)-1]
+
+	index := -1
+	for i, id := range round.Games {
+		if id == game.Id {
+			index = i
+		}
+	}
+
+	var resTags sdk.Tags
+	if game.Winner == WinnerDraw && index >= 0 && round.Replays[index] < maxTournamentReplays {
+		// Drawn games are replayed with the other player moving first
+		replay, res := k.StartGame(ctx, game.Player2, game.Player1, tournament.Variant, sdk.Coins{}, sdk.Coins{}, tournament.MoveTimeout)
+		if replay == nil {
+			ctx.Logger().With("module", "x/tictactoe").Info(
+				fmt.Sprintf("tournament %d cancelled: %s", tournament.Id, res.Log))
+			resTags = k.cancelTournament(ctx, tournament)
+			k.storeTournament(ctx, tournament)
+			return resTags
+		}
+
+		replay.TournamentId = tournament.Id
+		k.storeGame(ctx, replay)
+
+		round.Games[index] = replay.Id
+		round.Replays[index]++
+
+		k.storeTournament(ctx, tournament)
+		return nil
+	}
+
+	var loser sdk.AccAddress
+	switch {
+	case game.Winner == 1:
+		loser = game.Player2
+	case game.Winner == 2:
+		loser = game.Player1
+	case tournament.entrantIndex(game.Player1) < tournament.entrantIndex(game.Player2):
+		// Still drawn after the replays, entrants are in seeding order so the higher seed comes first
+		loser = game.Player2
+	default:
+		loser = game.Player1
+	}
+
+	i := tournament.entrantIndex(loser)
+	tournament.Entrants[i].Losses++
+	if tournament.Entrants[i].Losses == tournament.maxLosses() {
+		tournament.Eliminated = append(tournament.Eliminated, loser)
+	}
+
+	roundFinished := true
+	for _, id := range round.Games {
+		if id == game.Id {
+			continue
+		}
+
+		if other := k.getGame(ctx, id); other != nil && other.Winner == WinnerNone {
+			roundFinished = false
+			break
+		}
+	}
+
+	if roundFinished {
+		resTags = k.startRound(ctx, tournament)
+	}
+
+	k.storeTournament(ctx, tournament)
+
+	return resTags
+}
+
+// Ranks the entrants and pays out the prize pool
+func (k Keeper) finishTournament(ctx sdk.Context, tournament *Tournament) sdk.Tags {
+	var standings []sdk.AccAddress
+	for _, entrant := range tournament.Entrants {
+		if entrant.Losses < tournament.maxLosses() {
+			standings = append(standings, entrant.Address)
+		}
+	}
+
+	for i := len(tournament.Eliminated) - 1; i >= 0; i-- {
+		standings = append(standings, tournament.Eliminated[i])
+	}
+
+	tournament.Standings = standings
+	tournament.Status = TournamentFinished
+	k.refundGasAllowance(ctx, AllowanceTournament, tournament.Id)
+
+	k.payPrizes(ctx, tournament.PrizePool, tournament.PrizeShares, standings)
+
+	return sdk.NewTags(
+		TagTournamentId, strconv.Itoa(int(tournament.Id)),
+		TagTournamentWinner, standings[0].String(),
+	)
+}
+
+// Splits a prize pool between the places which get a prize, whatever is left after
+// rounding or because there are fewer players than prizes goes to the winner
+func (k Keeper) payPrizes(ctx sdk.Context, pool sdk.Coins, shares []sdk.Dec, standings []sdk.AccAddress) {
+	paid := sdk.Coins{}
+	for place, share := range shares {
+		if place == 0 || place >= len(standings) {
+			continue
+		}
+
+		prize := sdk.Coins{}
+		for _, coin := range pool {
+			amount := share.MulInt(coin.Amount).TruncateInt()
+			if amount.IsPositive() {
+				prize = append(prize, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+
+		if !prize.IsZero() {
+			k.addCoins(ctx, standings[place], prize)
+			paid = paid.Add(prize)
+		}
+	}
+
+	if winnerPrize := pool.Sub(paid); !winnerPrize.IsZero() {
+		k.addCoins(ctx, standings[0], winnerPrize)
+	}
+}
+
+// Gives every entrant the entry fee back
+func (k Keeper) cancelTournament(ctx sdk.Context, tournament *Tournament) sdk.Tags {
+	tournament.Status = TournamentCancelled
+	k.refundGasAllowance(ctx, AllowanceTournament, tournament.Id)
+
+	if !tournament.EntryFee.IsZero() {
+		for _, entrant := range tournament.Entrants {
+			k.addCoins(ctx, entrant.Address, tournament.EntryFee)
+		}
+	}
+
+	tournament.PrizePool = sdk.Coins{}
+
+	return sdk.NewTags(
+		TagTournamentId, strconv.Itoa(int(tournament.Id)),
+		TagTournamentStatus, TournamentCancelled,
+	)
+}
+
+func ParsePrizeShares(sharesStr string) ([]sdk.Dec, error) {
+	var shares []sdk.Dec
+	for _, shareStr := range strings.Split(sharesStr, ",") {
+		share, err := sdk.NewDecFromStr(strings.TrimSpace(shareStr))
+		if err != nil {
+			return nil, err
+		}
+
+		shares = append(shares, share)
+	}
+
+	return shares, nil
+}
@@ -0,0 +1,202 @@
+package tic_tac_toe
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// A tournament with an entry fee of 10abc and prizes for three places if there are enough entrants.
+// Every entrant starts with 100abc and registers in the order given.
+func createTestTournament(t *testing.T, input testInput, format string, names ...string) *Tournament {
+	shares := []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1)}
+	if len(names) < len(shares) {
+		shares = []sdk.Dec{sdk.OneDec()}
+	}
+
+	tournament, res := input.k.CreateTournament(input.ctx, testAddress("organiser"), format, VariantClassic,
+		mustParseCoins(t, "10abc"), shares, uint(len(names)), 10)
+	require.True(t, res.IsOK(), res.Log)
+
+	for _, name := range names {
+		require.True(t, input.k.Register(input.ctx, tournament.Id, input.fund(t, name, "100abc")).IsOK())
+	}
+
+	return tournament
+}
+
+// Plays the games of the current round, the entrant who comes first in strength wins and a nil strength draws every game
+func playTournamentRound(t *testing.T, input testInput, tournamentID uint, strength []string) {
+	tournament := input.k.getTournament(input.ctx, tournamentID)
+	require.Equal(t, TournamentRunning, tournament.Status)
+
+	for _, id := range tournament.Rounds[len(tournament.Rounds)-1].Games {
+		game := input.k.getGame(input.ctx, id)
+
+		game.Winner = WinnerDraw
+		for _, name := range strength {
+			if addr := testAddress(name); game.Player1.Equals(addr) || game.Player2.Equals(addr) {
+				game.Winner = testSeat(game, addr)
+				break
+			}
+		}
+
+		input.k.finishGame(input.ctx, game)
+		input.k.storeGame(input.ctx, game)
+	}
+}
+
+func TestTournamentSeeding(t *testing.T) {
+	var seeded [][]Entrant
+	for _, names := range [][]string{{"alice", "bob", "carol", "dave"}, {"dave", "carol", "bob", "alice"}} {
+		input := createTestInput(t)
+		tournament := createTestTournament(t, input, FormatSingleElimination, names...)
+		require.True(t, input.k.StartTournament(input.ctx, tournament.Id, testAddress("organiser")).IsOK())
+
+		// Highest seed plays the lowest one and moves first in the first round
+		tournament = input.k.getTournament(input.ctx, tournament.Id)
+		require.Len(t, tournament.Rounds, 1)
+		require.Empty(t, tournament.Rounds[0].Byes)
+		for i, id := range tournament.Rounds[0].Games {
+			game := input.k.getGame(input.ctx, id)
+			require.Equal(t, tournament.Entrants[i].Address, game.Player1)
+			require.Equal(t, tournament.Entrants[3-i].Address, game.Player2)
+			require.Equal(t, tournament.Id, game.TournamentId)
+		}
+
+		seeded = append(seeded, tournament.Entrants)
+	}
+
+	// The order of registration does not change the bracket
+	require.Equal(t, seeded[0], seeded[1])
+}
+
+func TestTournamentBye(t *testing.T) {
+	input := createTestInput(t)
+	tournament := createTestTournament(t, input, FormatSingleElimination, "alice", "bob", "carol")
+	require.True(t, input.k.StartTournament(input.ctx, tournament.Id, testAddress("organiser")).IsOK())
+
+	// The highest seed sits out the first round and meets the winner of the other game
+	tournament = input.k.getTournament(input.ctx, tournament.Id)
+	require.Len(t, tournament.Rounds[0].Games, 1)
+	require.Equal(t, []sdk.AccAddress{tournament.Entrants[0].Address}, tournament.Rounds[0].Byes)
+
+	playTournamentRound(t, input, tournament.Id, []string{"alice", "bob", "carol"})
+
+	tournament = input.k.getTournament(input.ctx, tournament.Id)
+	require.Len(t, tournament.Rounds, 2)
+	final := input.k.getGame(input.ctx, tournament.Rounds[1].Games[0])
+	require.True(t, final.Player1.Equals(tournament.Entrants[0].Address) || final.Player2.Equals(tournament.Entrants[0].Address))
+
+	playTournamentRound(t, input, tournament.Id, []string{"alice", "bob", "carol"})
+
+	tournament = input.k.getTournament(input.ctx, tournament.Id)
+	require.Equal(t, TournamentFinished, tournament.Status)
+	require.Len(t, tournament.Standings, 3)
+	require.Equal(t, testAddress("alice"), tournament.Standings[0])
+}
+
+func TestDoubleElimination(t *testing.T) {
+	input := createTestInput(t)
+	tournament := createTestTournament(t, input, FormatDoubleElimination, "dave", "carol", "bob", "alice")
+	require.True(t, input.k.StartTournament(input.ctx, tournament.Id, testAddress("organiser")).IsOK())
+
+	strength := []string{"alice", "bob", "carol", "dave"}
+	for input.k.getTournament(input.ctx, tournament.Id).Status == TournamentRunning {
+		playTournamentRound(t, input, tournament.Id, strength)
+	}
+
+	// Bob only ever loses to alice, so he wins the losers bracket and loses the grand final
+	tournament = input.k.getTournament(input.ctx, tournament.Id)
+	grandFinal := input.k.getGame(input.ctx, tournament.Rounds[len(tournament.Rounds)-1].Games[0])
+	require.Equal(t, testAddress("alice"), grandFinal.Player(grandFinal.Winner))
+	require.Equal(t, testAddress("bob"), grandFinal.Player(otherPlayer(grandFinal.Winner)))
+
+	var standings []sdk.AccAddress
+	for _, name := range strength {
+		standings = append(standings, testAddress(name))
+	}
+	require.Equal(t, standings, tournament.Standings)
+
+	require.Equal(t, mustParseCoins(t, "110abc"), input.balance(testAddress("alice")))
+	require.Equal(t, mustParseCoins(t, "102abc"), input.balance(testAddress("bob")))
+	require.Equal(t, mustParseCoins(t, "98abc"), input.balance(testAddress("carol")))
+	require.Equal(t, mustParseCoins(t, "90abc"), input.balance(testAddress("dave")))
+}
+
+func TestPayPrizes(t *testing.T) {
+	tests := []struct {
+		name      string
+		standings []string
+		prizes    []string
+	}{
+		{"every place paid", []string{"alice", "bob", "carol"}, []string{"50abc,4xyz", "30abc,2xyz", "20abc,1xyz"}},
+		{"unpaid place goes to the winner", []string{"alice", "bob"}, []string{"70abc,5xyz", "30abc,2xyz"}},
+		{"winner only", []string{"alice"}, []string{"100abc,7xyz"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+
+			var standings []sdk.AccAddress
+			for _, name := range tc.standings {
+				standings = append(standings, testAddress(name))
+			}
+
+			shares := []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1)}
+			input.k.payPrizes(input.ctx, mustParseCoins(t, "100abc,7xyz"), shares, standings)
+
+			for i, addr := range standings {
+				require.Equal(t, mustParseCoins(t, tc.prizes[i]), input.balance(addr), tc.standings[i])
+			}
+		})
+	}
+}
+
+func TestTournamentDraws(t *testing.T) {
+	input := createTestInput(t)
+	tournament := createTestTournament(t, input, FormatSingleElimination, "alice", "bob")
+	require.True(t, input.k.StartTournament(input.ctx, tournament.Id, testAddress("organiser")).IsOK())
+
+	for replays := uint(1); replays <= maxTournamentReplays; replays++ {
+		drawn := input.k.getGame(input.ctx, input.k.getTournament(input.ctx, tournament.Id).Rounds[0].Games[0])
+		playTournamentRound(t, input, tournament.Id, nil)
+
+		// Replayed with the other player moving first
+		tournament = input.k.getTournament(input.ctx, tournament.Id)
+		require.Equal(t, replays, tournament.Rounds[0].Replays[0])
+		replay := input.k.getGame(input.ctx, tournament.Rounds[0].Games[0])
+		require.NotEqual(t, drawn.Id, replay.Id)
+		require.Equal(t, drawn.Player2, replay.Player1)
+		require.Equal(t, drawn.Player1, replay.Player2)
+	}
+
+	// Still drawn after the last replay, the higher seed advances
+	playTournamentRound(t, input, tournament.Id, nil)
+
+	tournament = input.k.getTournament(input.ctx, tournament.Id)
+	require.Equal(t, TournamentFinished, tournament.Status)
+	require.Equal(t, tournament.Entrants[0].Address, tournament.Standings[0])
+	require.Equal(t, mustParseCoins(t, "110abc"), input.balance(tournament.Entrants[0].Address))
+}
+
+func TestCancelTournament(t *testing.T) {
+	input := createTestInput(t)
+	tournament := createTestTournament(t, input, FormatSingleElimination, "alice", "bob")
+	require.Equal(t, mustParseCoins(t, "90abc"), input.balance(testAddress("alice")))
+
+	require.False(t, input.k.CancelTournament(input.ctx, tournament.Id, testAddress("alice")).IsOK())
+	require.True(t, input.k.CancelTournament(input.ctx, tournament.Id, testAddress("organiser")).IsOK())
+
+	require.Equal(t, TournamentCancelled, input.k.getTournament(input.ctx, tournament.Id).Status)
+	require.Equal(t, mustParseCoins(t, "100abc"), input.balance(testAddress("alice")))
+	require.Equal(t, mustParseCoins(t, "100abc"), input.balance(testAddress("bob")))
+	require.False(t, input.k.StartTournament(input.ctx, tournament.Id, testAddress("organiser")).IsOK())
+
+	// A running tournament is played out
+	tournament = createTestTournament(t, input, FormatSingleElimination, "carol", "dave")
+	require.True(t, input.k.StartTournament(input.ctx, tournament.Id, testAddress("organiser")).IsOK())
+	require.False(t, input.k.CancelTournament(input.ctx, tournament.Id, testAddress("organiser")).IsOK())
+}